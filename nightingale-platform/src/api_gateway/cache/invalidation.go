@@ -0,0 +1,75 @@
+package cache
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+)
+
+// InvalidationBus propagates cache invalidations across gateway replicas
+// using Redis pub/sub: when one replica clears a key after a write, every
+// other replica's TwoTier drops it from its local layer too, instead of
+// serving a stale value for up to TwoTier's local TTL.
+//
+// Publishing a key through the bus is in addition to, not instead of,
+// invalidating it on the publishing replica itself — the bus only reaches
+// other subscribers.
+type InvalidationBus struct {
+	rdb     redis.UniversalClient
+	channel string
+	log     *logrus.Logger
+	pubsub  *redis.PubSub
+	done    chan struct{}
+}
+
+// NewInvalidationBus constructs an InvalidationBus on the given pub/sub
+// channel. Call Subscribe to start listening for invalidations from other
+// replicas.
+func NewInvalidationBus(rdb redis.UniversalClient, channel string, log *logrus.Logger) *InvalidationBus {
+	return &InvalidationBus{
+		rdb:     rdb,
+		channel: channel,
+		log:     log,
+		done:    make(chan struct{}),
+	}
+}
+
+// Publish announces that key was invalidated on this replica so every other
+// subscribed replica drops it from its local cache.
+func (b *InvalidationBus) Publish(ctx context.Context, key string) error {
+	return b.rdb.Publish(ctx, b.channel, key).Err()
+}
+
+// Subscribe starts a background loop that calls invalidateLocal for every
+// key announced by other replicas, until Close is called. Callers wire this
+// to a TwoTier cache's InvalidateLocal method at startup.
+func (b *InvalidationBus) Subscribe(invalidateLocal func(key string)) {
+	b.pubsub = b.rdb.Subscribe(context.Background(), b.channel)
+	go b.listen(invalidateLocal)
+}
+
+func (b *InvalidationBus) listen(invalidateLocal func(key string)) {
+	ch := b.pubsub.Channel()
+	for {
+		select {
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			invalidateLocal(msg.Payload)
+		case <-b.done:
+			return
+		}
+	}
+}
+
+// Close stops the subscriber loop. Safe to call even if Subscribe was never
+// called.
+func (b *InvalidationBus) Close() error {
+	close(b.done)
+	if b.pubsub != nil {
+		return b.pubsub.Close()
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+)
+
+func TestInvalidationBus_SubscriberInvalidatesLocalOnPublish(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	defer mr.Close()
+
+	publisher := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer publisher.Close()
+	subscriber := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer subscriber.Close()
+
+	log := logrus.New()
+	log.SetOutput(noopWriter{})
+
+	// Simulate a second gateway replica: its own TwoTier, invalidated only
+	// through the bus, never directly.
+	tc := NewTwoTier(subscriber, 16, time.Minute)
+	ctx := context.Background()
+	tc.setLocal("demo:1", "Jane Doe")
+
+	bus := NewInvalidationBus(subscriber, "cache:invalidate", log)
+	bus.Subscribe(tc.InvalidateLocal)
+	defer bus.Close()
+
+	// Give the subscriber goroutine a moment to register before publishing.
+	time.Sleep(50 * time.Millisecond)
+
+	publisherBus := NewInvalidationBus(publisher, "cache:invalidate", log)
+	if err := publisherBus.Publish(ctx, "demo:1"); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		tc.mu.Lock()
+		_, stillLocal := tc.local["demo:1"]
+		tc.mu.Unlock()
+		if !stillLocal {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("local entry was not invalidated after a publish from another replica")
+}
+
+type noopWriter struct{}
+
+func (noopWriter) Write(p []byte) (int, error) { return len(p), nil }
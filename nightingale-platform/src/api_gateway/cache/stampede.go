@@ -0,0 +1,87 @@
+// Package cache provides caching primitives shared across gateway handlers:
+// stampede protection, a two-tier local+Redis cache, and cross-instance
+// invalidation.
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// lockTTL bounds how long a recompute lock can be held, so a crashed
+// holder cannot wedge the key forever.
+const lockTTL = 5 * time.Second
+
+// StampedeGuard prevents an expiring hot key from sending every concurrent
+// request to the backend at once. The first caller to see a miss acquires
+// a short-lived Redis mutex (SETNX) and recomputes the value; every other
+// concurrent caller polls briefly for the fresh value instead of also
+// recomputing.
+type StampedeGuard struct {
+	rdb redis.UniversalClient
+}
+
+// NewStampedeGuard constructs a StampedeGuard backed by rdb.
+func NewStampedeGuard(rdb redis.UniversalClient) *StampedeGuard {
+	return &StampedeGuard{rdb: rdb}
+}
+
+// GetOrRecompute returns the cached value at key, or, on a cache miss,
+// recomputes it via fn — but only once across all concurrent callers for
+// that key. Callers that lose the race for the recompute lock wait for the
+// winner to populate the key, polling for up to lockTTL.
+func (g *StampedeGuard) GetOrRecompute(ctx context.Context, key string, ttl time.Duration, fn func() (string, error)) (string, error) {
+	if val, err := g.rdb.Get(ctx, key).Result(); err == nil {
+		return val, nil
+	} else if err != redis.Nil {
+		return "", err
+	}
+
+	lockKey := key + ":lock"
+	acquired, err := g.rdb.SetNX(ctx, lockKey, "1", lockTTL).Result()
+	if err != nil {
+		return "", err
+	}
+
+	if acquired {
+		defer g.rdb.Del(ctx, lockKey)
+		val, err := fn()
+		if err != nil {
+			return "", err
+		}
+		if err := g.rdb.Set(ctx, key, val, ttl).Err(); err != nil {
+			return "", err
+		}
+		return val, nil
+	}
+
+	return g.waitForValue(ctx, key)
+}
+
+// waitForValue polls key until the recompute winner populates it or the
+// lock TTL elapses, whichever comes first.
+func (g *StampedeGuard) waitForValue(ctx context.Context, key string) (string, error) {
+	deadline := time.Now().Add(lockTTL)
+	ticker := time.NewTicker(25 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-ticker.C:
+			val, err := g.rdb.Get(ctx, key).Result()
+			if err == nil {
+				return val, nil
+			}
+			if err != redis.Nil {
+				return "", err
+			}
+			if time.Now().After(deadline) {
+				return "", redis.Nil
+			}
+		}
+	}
+}
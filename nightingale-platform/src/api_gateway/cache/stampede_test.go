@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestStampedeGuard_RecomputesExactlyOnceUnderConcurrentMiss(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	defer mr.Close()
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+
+	guard := NewStampedeGuard(rdb)
+
+	var recomputeCount int32
+	fn := func() (string, error) {
+		atomic.AddInt32(&recomputeCount, 1)
+		time.Sleep(50 * time.Millisecond)
+		return "fresh-value", nil
+	}
+
+	const concurrency = 20
+	var wg sync.WaitGroup
+	results := make([]string, concurrency)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			val, err := guard.GetOrRecompute(context.Background(), "hot-key", time.Minute, fn)
+			if err != nil {
+				t.Errorf("GetOrRecompute: %v", err)
+				return
+			}
+			results[idx] = val
+		}(i)
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&recomputeCount); got != 1 {
+		t.Fatalf("recomputeCount = %d, want exactly 1", got)
+	}
+	for i, v := range results {
+		if v != "fresh-value" {
+			t.Errorf("caller %d got %q, want fresh-value", i, v)
+		}
+	}
+}
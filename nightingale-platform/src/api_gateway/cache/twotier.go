@@ -0,0 +1,133 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// localEntry is one cached value held in the in-process LRU layer.
+type localEntry struct {
+	value     string
+	expiresAt time.Time
+}
+
+// TwoTier puts a small in-process LRU cache in front of Redis for
+// extremely hot keys, such as patient demographics. It cuts Redis round
+// trips per request and keeps serving the last known value for the local
+// TTL if Redis has a brief blip.
+//
+// Invalidation across gateway replicas is handled by the InvalidationBus;
+// TwoTier itself only manages the local layer plus read-through to Redis.
+type TwoTier struct {
+	rdb redis.UniversalClient
+
+	mu       sync.Mutex
+	local    map[string]*localEntry
+	order    []string // LRU order, oldest first
+	capacity int
+	localTTL time.Duration
+}
+
+// NewTwoTier constructs a TwoTier cache with the given local capacity and
+// TTL, reading through to rdb on a local miss.
+func NewTwoTier(rdb redis.UniversalClient, capacity int, localTTL time.Duration) *TwoTier {
+	if capacity <= 0 {
+		capacity = 256
+	}
+	return &TwoTier{
+		rdb:      rdb,
+		local:    make(map[string]*localEntry, capacity),
+		capacity: capacity,
+		localTTL: localTTL,
+	}
+}
+
+// Get returns key's value, preferring the local layer. On a local miss it
+// reads Redis and, on a Redis miss or error, falls back to a locally cached
+// value whose local TTL has elapsed, whatever its age, so a brief Redis
+// blip doesn't turn into a hard failure for already-warm keys.
+func (t *TwoTier) Get(ctx context.Context, key string) (string, bool) {
+	t.mu.Lock()
+	entry, ok := t.local[key]
+	if ok && time.Now().Before(entry.expiresAt) {
+		t.removeFromOrder(key)
+		t.order = append(t.order, key)
+		t.mu.Unlock()
+		return entry.value, true
+	}
+	t.mu.Unlock()
+
+	val, err := t.rdb.Get(ctx, key).Result()
+	if err == nil {
+		t.setLocal(key, val)
+		return val, true
+	}
+
+	// Redis miss or unreachable: serve the stale local value if we have one.
+	if ok {
+		return entry.value, true
+	}
+	return "", false
+}
+
+// Set writes key to Redis and populates the local layer.
+func (t *TwoTier) Set(ctx context.Context, key, value string, redisTTL time.Duration) error {
+	if err := t.rdb.Set(ctx, key, value, redisTTL).Err(); err != nil {
+		return err
+	}
+	t.setLocal(key, value)
+	return nil
+}
+
+// InvalidateLocal removes key from the local layer only, used by the
+// cross-instance invalidation bus when another replica reports a write.
+func (t *TwoTier) InvalidateLocal(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.local, key)
+	t.removeFromOrder(key)
+}
+
+// Clear empties the entire local layer, used after an admin-triggered full
+// Redis flush so this replica doesn't keep serving locally-warm values that
+// the flush was meant to discard.
+func (t *TwoTier) Clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.local = make(map[string]*localEntry, t.capacity)
+	t.order = t.order[:0]
+}
+
+func (t *TwoTier) setLocal(key, value string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, exists := t.local[key]; exists {
+		t.removeFromOrder(key)
+	} else if len(t.local) >= t.capacity {
+		t.evictOldest()
+	}
+	t.order = append(t.order, key)
+	t.local[key] = &localEntry{value: value, expiresAt: time.Now().Add(t.localTTL)}
+}
+
+func (t *TwoTier) evictOldest() {
+	if len(t.order) == 0 {
+		return
+	}
+	oldest := t.order[0]
+	t.order = t.order[1:]
+	delete(t.local, oldest)
+}
+
+func (t *TwoTier) removeFromOrder(key string) {
+	for i, k := range t.order {
+		if k == key {
+			t.order = append(t.order[:i], t.order[i+1:]...)
+			return
+		}
+	}
+}
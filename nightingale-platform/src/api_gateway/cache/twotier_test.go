@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestTwoTier_LocalHitAvoidsRedisRoundTrip(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	defer mr.Close()
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+
+	tc := NewTwoTier(rdb, 16, time.Minute)
+	ctx := context.Background()
+
+	if err := tc.Set(ctx, "demo:1", "Jane Doe", time.Minute); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	// Take Redis down; a local hit must still succeed.
+	mr.Close()
+
+	val, ok := tc.Get(ctx, "demo:1")
+	if !ok || val != "Jane Doe" {
+		t.Fatalf("Get after Redis outage = (%q, %v), want (Jane Doe, true)", val, ok)
+	}
+}
+
+func TestTwoTier_EvictsOldestWhenCapacityExceeded(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	defer mr.Close()
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+
+	tc := NewTwoTier(rdb, 2, time.Minute)
+	ctx := context.Background()
+
+	tc.Set(ctx, "a", "1", time.Minute)
+	tc.Set(ctx, "b", "2", time.Minute)
+	tc.Set(ctx, "c", "3", time.Minute)
+
+	if len(tc.local) != 2 {
+		t.Fatalf("local cache size = %d, want 2 after exceeding capacity", len(tc.local))
+	}
+	if _, ok := tc.local["a"]; ok {
+		t.Error("oldest key \"a\" should have been evicted")
+	}
+}
+
+func TestTwoTier_InvalidateLocalForcesRedisRead(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	defer mr.Close()
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+
+	tc := NewTwoTier(rdb, 16, time.Minute)
+	ctx := context.Background()
+	tc.Set(ctx, "demo:1", "Jane Doe", time.Minute)
+
+	rdb.Set(ctx, "demo:1", "Jane Updated", time.Minute)
+	tc.InvalidateLocal("demo:1")
+
+	val, ok := tc.Get(ctx, "demo:1")
+	if !ok || val != "Jane Updated" {
+		t.Fatalf("Get after invalidation = (%q, %v), want (Jane Updated, true)", val, ok)
+	}
+}
@@ -0,0 +1,153 @@
+// Package config loads and holds the API Gateway's runtime configuration.
+package config
+
+import "time"
+
+// Config is the root configuration object for the gateway process.
+type Config struct {
+	Server       ServerConfig
+	JWT          JWTConfig
+	Redis        RedisConfig
+	RateLimit    RateLimitConfig
+	TokenBucket  TokenBucketConfig
+	Quota        QuotaConfig
+	Logging      LoggingConfig
+	ShadowMirror ShadowMirrorConfig
+	Upstreams    map[string]UpstreamConfig
+}
+
+// ServerConfig controls the gateway's own listener.
+type ServerConfig struct {
+	ListenAddr      string
+	ShutdownTimeout time.Duration
+}
+
+// JWTConfig controls bearer-token validation at the edge.
+type JWTConfig struct {
+	Secret          string
+	Issuer          string
+	Audience        string
+	PreviousSecret  string
+	RotationDeadline time.Time
+}
+
+// RedisConfig points the gateway at its Redis deployment. Addr is used for
+// a plain single-node deployment; set Sentinel or Cluster instead to run
+// against a Sentinel-backed failover group or a Redis Cluster.
+type RedisConfig struct {
+	Addr     string
+	Password string
+	DB       int
+	Sentinel SentinelConfig
+	Cluster  ClusterConfig
+}
+
+// SentinelConfig points the client at a Sentinel-monitored Redis failover
+// group instead of a fixed address.
+type SentinelConfig struct {
+	MasterName string
+	Addrs      []string
+}
+
+// ClusterConfig switches the client into Redis Cluster mode across a seed
+// list of cluster node addresses.
+type ClusterConfig struct {
+	Enabled bool
+	Addrs   []string
+}
+
+// RateLimitConfig configures the request-rate limiter.
+type RateLimitConfig struct {
+	RequestsPerMinute int
+	Burst             int
+	// Window is the rolling window used by the sliding-window limiter.
+	Window time.Duration
+}
+
+// TokenBucketConfig configures the Redis-backed token bucket used to
+// absorb bursty traffic to expensive routes (AI calls) without the strict
+// per-window cutoff of the sliding-window limiter.
+type TokenBucketConfig struct {
+	Capacity        int64
+	RefillPerSecond float64
+	LocalBatch      int64
+}
+
+// QuotaConfig sets the default long-horizon usage allowance applied to any
+// caller without an admin-set override, used for metering AI usage against
+// a clinic's contract rather than throttling request bursts. A limit of 0
+// means unlimited.
+type QuotaConfig struct {
+	DailyLimit   int64
+	MonthlyLimit int64
+}
+
+// LoggingConfig configures structured access logging, including which
+// additional sinks receive a copy of each log entry beyond the logger's
+// default stderr output.
+type LoggingConfig struct {
+	Level string
+
+	File   FileSinkConfig
+	Syslog SyslogSinkConfig
+	Loki   LokiSinkConfig
+}
+
+// FileSinkConfig writes JSON-formatted log entries to a local file,
+// rotating it once it exceeds MaxSizeBytes and keeping at most MaxBackups
+// rotated copies alongside it.
+type FileSinkConfig struct {
+	Enabled      bool
+	Path         string
+	MaxSizeBytes int64
+	MaxBackups   int
+}
+
+// SyslogSinkConfig forwards log entries to a syslog daemon. Network and
+// Addr select a remote syslog server; leave both empty to use the local
+// syslog socket.
+type SyslogSinkConfig struct {
+	Enabled bool
+	Network string
+	Addr    string
+	Tag     string
+}
+
+// LokiSinkConfig pushes log entries to a Grafana Loki instance over its
+// HTTP push API.
+//
+// Pushes are dispatched through a bounded worker pool: a slow or
+// unreachable Loki endpoint must never add latency to the request path
+// that produced the log entry, so once the queue is full further entries
+// are dropped rather than blocking the logger.
+type LokiSinkConfig struct {
+	Enabled        bool
+	PushURL        string
+	Labels         map[string]string
+	WorkerPoolSize int
+	QueueSize      int
+}
+
+// ShadowMirrorConfig controls asynchronous traffic mirroring to a shadow
+// upstream for pre-production comparison testing.
+//
+// Mirroring is fire-and-forget: a slow or unreachable shadow target must
+// never add latency to the client-facing request.
+type ShadowMirrorConfig struct {
+	Enabled    bool
+	SampleRate float64
+	TargetURL  string
+	// WorkerPoolSize bounds the number of goroutines dispatching mirrored
+	// requests so a stalled shadow target cannot leak goroutines.
+	WorkerPoolSize int
+	// QueueSize bounds the number of mirror jobs buffered ahead of the
+	// worker pool; once full, further mirror attempts are dropped rather
+	// than blocking the caller.
+	QueueSize int
+}
+
+// UpstreamConfig describes a single backend the gateway proxies to.
+type UpstreamConfig struct {
+	BaseURL string
+	Timeout time.Duration
+}
@@ -0,0 +1,234 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/nightingale-health/api-gateway/cache"
+	"github.com/nightingale-health/api-gateway/middleware"
+	"github.com/nightingale-health/api-gateway/quota"
+	"github.com/nightingale-health/api-gateway/redisclient"
+	"github.com/nightingale-health/api-gateway/schemadrift"
+)
+
+// AdminHandler groups operator-facing endpoints that are not part of the
+// public API surface (rate-limit inspection, JWT rotation status, cache
+// inspection, and similar). Every route under this handler is expected to
+// be mounted behind middleware.RequireAdmin.
+type AdminHandler struct {
+	rotationTracker *middleware.RotationTracker
+	rateLimiter     *middleware.SlidingWindowLimiter
+	redis           *redisclient.RedisClient
+	localCache      *cache.TwoTier
+	invalidation    *cache.InvalidationBus
+	schemaDrift     *schemadrift.Detector
+	quota           *quota.Quota
+	allowlist       *middleware.Allowlist
+}
+
+// NewAdminHandler constructs an AdminHandler. rotationTracker and
+// rateLimiter may be nil if the corresponding feature is disabled; the
+// rate-limit endpoints then report 404.
+// localCache and invalidation may be nil; when set, ClearCache also drops
+// this replica's local tier and broadcasts the flush to every other
+// replica so none of them keep serving stale locally-warm values.
+// schemaDrift may be nil, in which case SchemaDrift reports an empty count.
+// quota may be nil, in which case the quota endpoints report 404.
+// allowlist may be nil, in which case the exemption endpoints report 404.
+func NewAdminHandler(rotationTracker *middleware.RotationTracker, rateLimiter *middleware.SlidingWindowLimiter, redis *redisclient.RedisClient, localCache *cache.TwoTier, invalidation *cache.InvalidationBus, schemaDrift *schemadrift.Detector, q *quota.Quota, allowlist *middleware.Allowlist) *AdminHandler {
+	return &AdminHandler{rotationTracker: rotationTracker, rateLimiter: rateLimiter, redis: redis, localCache: localCache, invalidation: invalidation, schemaDrift: schemaDrift, quota: q, allowlist: allowlist}
+}
+
+// ListRateLimitExemptions returns every caller currently exempt from rate
+// limiting and quota enforcement.
+func (h *AdminHandler) ListRateLimitExemptions(c *gin.Context) {
+	if h.allowlist == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": gin.H{"code": "GATEWAY_404", "message": "rate-limit allowlist is not enabled"}})
+		return
+	}
+	entries, err := h.allowlist.List(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": gin.H{"code": "GATEWAY_502", "message": "failed to read allowlist"}})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"exemptions": entries})
+}
+
+type addRateLimitExemptionRequest struct {
+	Entry string `json:"entry" binding:"required"`
+}
+
+// AddRateLimitExemption exempts an IP, user ID, or API key from rate
+// limiting and quota enforcement.
+func (h *AdminHandler) AddRateLimitExemption(c *gin.Context) {
+	if h.allowlist == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": gin.H{"code": "GATEWAY_404", "message": "rate-limit allowlist is not enabled"}})
+		return
+	}
+	var req addRateLimitExemptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "GATEWAY_400", "message": err.Error()}})
+		return
+	}
+	if err := h.allowlist.Add(c.Request.Context(), req.Entry); err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": gin.H{"code": "GATEWAY_502", "message": "failed to update allowlist"}})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// RemoveRateLimitExemption revokes a caller's exemption.
+func (h *AdminHandler) RemoveRateLimitExemption(c *gin.Context) {
+	if h.allowlist == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": gin.H{"code": "GATEWAY_404", "message": "rate-limit allowlist is not enabled"}})
+		return
+	}
+	if err := h.allowlist.Remove(c.Request.Context(), c.Param("entry")); err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": gin.H{"code": "GATEWAY_502", "message": "failed to update allowlist"}})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// GetQuota reports a caller's current daily/monthly usage and effective
+// limits, so support staff can see exactly why a request was rejected.
+func (h *AdminHandler) GetQuota(c *gin.Context) {
+	if h.quota == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": gin.H{"code": "GATEWAY_404", "message": "quota management is not enabled"}})
+		return
+	}
+	caller := c.Param("caller")
+	usage, err := h.quota.Usage(c.Request.Context(), caller, time.Now())
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": gin.H{"code": "GATEWAY_502", "message": "failed to read quota usage"}})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"caller":        caller,
+		"daily_used":    usage.Daily,
+		"monthly_used":  usage.Monthly,
+		"daily_limit":   usage.Limits.Daily,
+		"monthly_limit": usage.Limits.Monthly,
+	})
+}
+
+type setQuotaRequest struct {
+	Daily   int64 `json:"daily"`
+	Monthly int64 `json:"monthly"`
+}
+
+// SetQuota overrides a caller's daily/monthly limits, e.g. when a clinic
+// upgrades its contract mid-cycle. A limit of 0 means unlimited.
+func (h *AdminHandler) SetQuota(c *gin.Context) {
+	if h.quota == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": gin.H{"code": "GATEWAY_404", "message": "quota management is not enabled"}})
+		return
+	}
+	var req setQuotaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "GATEWAY_400", "message": err.Error()}})
+		return
+	}
+	caller := c.Param("caller")
+	if err := h.quota.SetLimits(c.Request.Context(), caller, quota.Limits{Daily: req.Daily, Monthly: req.Monthly}); err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": gin.H{"code": "GATEWAY_502", "message": "failed to update quota"}})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// SchemaDrift reports the cumulative count of upstream responses that
+// didn't match the expected schema, broken down by schema name, so an
+// operator can catch a backend contract change before it breaks clients.
+func (h *AdminHandler) SchemaDrift(c *gin.Context) {
+	if h.schemaDrift == nil {
+		c.JSON(http.StatusOK, gin.H{"counts": gin.H{}})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"counts": h.schemaDrift.Counts()})
+}
+
+// invalidateAllKey is the sentinel payload published on the invalidation
+// bus by ClearCache: every other replica's TwoTier treats it as "drop your
+// entire local layer" rather than a single key.
+const invalidateAllKey = "*"
+
+// GetRateLimitState reports a caller's current sliding-window counters, so
+// support staff can see exactly why a request was throttled.
+func (h *AdminHandler) GetRateLimitState(c *gin.Context) {
+	if h.rateLimiter == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": gin.H{"code": "GATEWAY_404", "message": "rate limiting is not enabled"}})
+		return
+	}
+	key := c.Query("key")
+	if key == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "GATEWAY_400", "message": "key query parameter is required"}})
+		return
+	}
+	state, err := h.rateLimiter.Inspect(c.Request.Context(), h.rateLimiter.Key(key))
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": gin.H{"code": "GATEWAY_502", "message": "failed to read rate-limit state"}})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"key":       key,
+		"count":     state.Count,
+		"limit":     state.Limit,
+		"window_ms": state.WindowMs,
+	})
+}
+
+// ResetRateLimit clears a caller's sliding-window state entirely, so
+// support staff can immediately unblock a legitimately throttled clinician
+// during an emergency.
+func (h *AdminHandler) ResetRateLimit(c *gin.Context) {
+	if h.rateLimiter == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": gin.H{"code": "GATEWAY_404", "message": "rate limiting is not enabled"}})
+		return
+	}
+	key := c.Param("key")
+	if err := h.rateLimiter.Reset(c.Request.Context(), h.rateLimiter.Key(key)); err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": gin.H{"code": "GATEWAY_502", "message": "failed to reset rate-limit state"}})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// JWTRotationStatus reports the fraction of validated traffic still using
+// the previous JWT secret, so an operator can tell when a secret rotation
+// is safe to finalize.
+func (h *AdminHandler) JWTRotationStatus(c *gin.Context) {
+	if h.rotationTracker == nil {
+		c.JSON(http.StatusOK, gin.H{"previous_secret_fraction": 0, "current_secret_hits": 0, "previous_secret_hits": 0})
+		return
+	}
+	fraction, current, previous := h.rotationTracker.Status()
+	c.JSON(http.StatusOK, gin.H{
+		"previous_secret_fraction": fraction,
+		"current_secret_hits":      current,
+		"previous_secret_hits":     previous,
+	})
+}
+
+// ClearCache flushes the entire Redis keyspace, then clears this replica's
+// local cache tier and, if an invalidation bus is configured, broadcasts
+// the flush so every other replica drops its local tier too. All-or-nothing
+// today; see BT-05-035 for the scoped, per-namespace replacement.
+func (h *AdminHandler) ClearCache(c *gin.Context) {
+	if err := h.redis.FlushAll(); err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": gin.H{"code": "GATEWAY_502", "message": "failed to clear cache"}})
+		return
+	}
+	if h.localCache != nil {
+		h.localCache.Clear()
+	}
+	if h.invalidation != nil {
+		if err := h.invalidation.Publish(c.Request.Context(), invalidateAllKey); err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": gin.H{"code": "GATEWAY_502", "message": "cache cleared locally but failed to notify other replicas"}})
+			return
+		}
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,241 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/nightingale-health/api-gateway/cache"
+	"github.com/nightingale-health/api-gateway/middleware"
+	"github.com/nightingale-health/api-gateway/quota"
+	"github.com/nightingale-health/api-gateway/redisclient"
+)
+
+func newTestAdminHandler(t *testing.T) (*AdminHandler, *middleware.SlidingWindowLimiter, func()) {
+	t.Helper()
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	limiter := middleware.NewSlidingWindowLimiter(rdb, time.Minute, 5)
+	redisClient := redisclient.NewRedisClient(mr.Addr(), "", 0)
+	return NewAdminHandler(nil, limiter, redisClient, nil, nil, nil, nil, nil), limiter, func() {
+		rdb.Close()
+		mr.Close()
+	}
+}
+
+func newTestAdminHandlerWithQuota(t *testing.T) (*AdminHandler, func()) {
+	t.Helper()
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	q := quota.NewQuota(rdb, quota.Limits{Daily: 100, Monthly: 1000})
+	return NewAdminHandler(nil, nil, nil, nil, nil, nil, q, nil), func() {
+		rdb.Close()
+		mr.Close()
+	}
+}
+
+func TestAdminHandler_SetQuotaThenGetQuotaReflectsOverride(t *testing.T) {
+	h, cleanup := newTestAdminHandlerWithQuota(t)
+	defer cleanup()
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/admin/quotas/:caller", h.GetQuota)
+	r.PUT("/admin/quotas/:caller", h.SetQuota)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/admin/quotas/clinic-9", strings.NewReader(`{"daily":5,"monthly":50}`)))
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("SetQuota status = %d, want 204: %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/quotas/clinic-9", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetQuota status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), `"daily_limit":5`) || !strings.Contains(w.Body.String(), `"monthly_limit":50`) {
+		t.Fatalf("body = %s, want overridden limits", w.Body.String())
+	}
+}
+
+func TestAdminHandler_InspectThenResetRestoresAccess(t *testing.T) {
+	h, limiter, cleanup := newTestAdminHandler(t)
+	defer cleanup()
+
+	// Exhaust the limit for a throttled caller.
+	for i := 0; i < 5; i++ {
+		if _, err := limiter.Allow(context.Background(), limiter.Key("clinician-1"), time.Now()); err != nil {
+			t.Fatalf("Allow: %v", err)
+		}
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/admin/rate-limits", h.GetRateLimitState)
+	r.DELETE("/admin/rate-limits/:key", h.ResetRateLimit)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/rate-limits?key=clinician-1", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("inspect status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+	if !contains(w.Body.String(), `"count":5`) {
+		t.Fatalf("expected count=5 in inspect response, got %s", w.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodDelete, "/admin/rate-limits/clinician-1", nil)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("reset status = %d, want 204", w.Code)
+	}
+
+	if _, err := limiter.Allow(context.Background(), limiter.Key("clinician-1"), time.Now()); err != nil {
+		t.Fatalf("Allow after reset: %v", err)
+	}
+	state, err := limiter.Inspect(context.Background(), limiter.Key("clinician-1"))
+	if err != nil {
+		t.Fatalf("Inspect after reset: %v", err)
+	}
+	if state.Count != 1 {
+		t.Fatalf("count after reset+one request = %d, want 1 (caller should be admitted immediately after an admin reset)", state.Count)
+	}
+}
+
+func TestAdminHandler_ClearCacheFlushesRedis(t *testing.T) {
+	h, _, cleanup := newTestAdminHandler(t)
+	defer cleanup()
+
+	if err := h.redis.Set("some:key", "value", time.Minute); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/admin/cache/clear", h.ClearCache)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/admin/cache/clear", nil))
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want 204: %s", w.Code, w.Body.String())
+	}
+
+	if _, err := h.redis.Get("some:key"); err == nil {
+		t.Fatal("expected key to be gone after ClearCache")
+	}
+}
+
+func TestAdminHandler_ClearCacheClearsLocalTierAndBroadcasts(t *testing.T) {
+	h, _, cleanup := newTestAdminHandler(t)
+	defer cleanup()
+
+	localCache := cache.NewTwoTier(h.redis.Raw(), 0, time.Minute)
+	localCache.Set(context.Background(), "patient:p1", "stale", time.Minute)
+	h.localCache = localCache
+
+	bus := cache.NewInvalidationBus(h.redis.Raw(), "cache:invalidate:test", nil)
+	var received string
+	done := make(chan struct{})
+	bus.Subscribe(func(key string) {
+		received = key
+		close(done)
+	})
+	defer bus.Close()
+	h.invalidation = bus
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/admin/cache/clear", h.ClearCache)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/admin/cache/clear", nil))
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want 204: %s", w.Code, w.Body.String())
+	}
+
+	if _, ok := localCache.Get(context.Background(), "patient:p1"); ok {
+		t.Fatal("expected local tier to be cleared after ClearCache")
+	}
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for invalidation broadcast")
+	}
+	if received != invalidateAllKey {
+		t.Fatalf("broadcast payload = %q, want %q", received, invalidateAllKey)
+	}
+}
+
+func contains(haystack, needle string) bool {
+	for i := 0; i+len(needle) <= len(haystack); i++ {
+		if haystack[i:i+len(needle)] == needle {
+			return true
+		}
+	}
+	return false
+}
+
+func newTestAdminHandlerWithAllowlist(t *testing.T) (*AdminHandler, func()) {
+	t.Helper()
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	allowlist := middleware.NewAllowlist(rdb)
+	return NewAdminHandler(nil, nil, nil, nil, nil, nil, nil, allowlist), func() {
+		rdb.Close()
+		mr.Close()
+	}
+}
+
+func TestAdminHandler_AddThenListThenRemoveRateLimitExemption(t *testing.T) {
+	h, cleanup := newTestAdminHandlerWithAllowlist(t)
+	defer cleanup()
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/admin/rate-limit-exemptions", h.ListRateLimitExemptions)
+	r.POST("/admin/rate-limit-exemptions", h.AddRateLimitExemption)
+	r.DELETE("/admin/rate-limit-exemptions/:entry", h.RemoveRateLimitExemption)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/admin/rate-limit-exemptions", strings.NewReader(`{"entry":"clinic-trusted"}`)))
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("Add status = %d, want 204: %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/rate-limit-exemptions", nil))
+	if !strings.Contains(w.Body.String(), "clinic-trusted") {
+		t.Fatalf("body = %s, want clinic-trusted listed", w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/admin/rate-limit-exemptions/clinic-trusted", nil))
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("Remove status = %d, want 204: %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/admin/rate-limit-exemptions", nil))
+	if strings.Contains(w.Body.String(), "clinic-trusted") {
+		t.Fatalf("body = %s, want clinic-trusted removed", w.Body.String())
+	}
+}
@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/nightingale-health/api-gateway/proxy"
+)
+
+// AIProxy forwards clinical AI requests to the model-serving upstream.
+type AIProxy struct {
+	proxy   *proxy.Proxy
+	baseURL string
+}
+
+// NewAIProxy constructs an AIProxy forwarding to baseURL.
+func NewAIProxy(p *proxy.Proxy, baseURL string) *AIProxy {
+	return &AIProxy{proxy: p, baseURL: baseURL}
+}
+
+// SummarizeClinicalNote forwards a note-summarization request to the AI
+// service.
+func (a *AIProxy) SummarizeClinicalNote(c *gin.Context) {
+	resp, err := a.proxy.ForwardRequest(http.MethodPost, a.baseURL, "/summarize", c.Request.Header, c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": gin.H{"code": "GATEWAY_502", "message": "AI service unavailable"}})
+		return
+	}
+	defer resp.Body.Close()
+	c.DataFromReader(resp.StatusCode, resp.ContentLength, resp.Header.Get("Content-Type"), resp.Body, nil)
+}
+
+// explainTermsRequest is the body ExplainMedicalTerm expects and forwards
+// to the AI service. Terms is a batch so a client explaining several terms
+// from one note (e.g. a hover-to-define pass over a whole document) can do
+// it in a single upstream round trip instead of one request per term.
+type explainTermsRequest struct {
+	Terms []string `json:"terms" binding:"required,min=1,dive,required"`
+}
+
+// ExplainMedicalTerm forwards a batch term-explanation request to the AI
+// service as a JSON body. Previously this spliced a raw form field
+// unescaped into a query string; see BT-05-086.
+func (a *AIProxy) ExplainMedicalTerm(c *gin.Context) {
+	var req explainTermsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "GATEWAY_400", "message": "terms must be a non-empty array of strings"}})
+		return
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": gin.H{"code": "GATEWAY_500", "message": "failed to encode request"}})
+		return
+	}
+
+	header := c.Request.Header.Clone()
+	header.Set("Content-Type", "application/json")
+	resp, err := a.proxy.ForwardRequest(http.MethodPost, a.baseURL, "/explain", header, bytes.NewReader(payload))
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": gin.H{"code": "GATEWAY_502", "message": "AI service unavailable"}})
+		return
+	}
+	defer resp.Body.Close()
+	c.DataFromReader(resp.StatusCode, resp.ContentLength, resp.Header.Get("Content-Type"), resp.Body, nil)
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/nightingale-health/api-gateway/proxy"
+)
+
+func TestAIProxy_ExplainMedicalTerm_SendsJSONBodyWithBatchedTerms(t *testing.T) {
+	var gotBody explainTermsRequest
+	var gotContentType string
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Errorf("decode backend request body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"explanations":{}}`))
+	}))
+	defer backend.Close()
+
+	a := NewAIProxy(proxy.NewProxy(0), backend.URL)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/ai/explain", a.ExplainMedicalTerm)
+
+	payload, _ := json.Marshal(explainTermsRequest{Terms: []string{"hypertension", "tachycardia"}})
+	req := httptest.NewRequest(http.MethodPost, "/ai/explain", bytes.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+	if gotContentType != "application/json" {
+		t.Fatalf("backend received Content-Type %q, want application/json", gotContentType)
+	}
+	if len(gotBody.Terms) != 2 || gotBody.Terms[0] != "hypertension" || gotBody.Terms[1] != "tachycardia" {
+		t.Fatalf("backend received terms %v, want [hypertension tachycardia]", gotBody.Terms)
+	}
+}
+
+func TestAIProxy_ExplainMedicalTerm_RejectsEmptyTerms(t *testing.T) {
+	a := NewAIProxy(proxy.NewProxy(0), "http://unused.invalid")
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/ai/explain", a.ExplainMedicalTerm)
+
+	req := httptest.NewRequest(http.MethodPost, "/ai/explain", strings.NewReader(`{"terms":[]}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400: %s", w.Code, w.Body.String())
+	}
+}
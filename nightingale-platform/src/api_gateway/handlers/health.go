@@ -0,0 +1,13 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DeepHealthHandler reports downstream health. Today it always reports
+// healthy; see BT-05-043 for live upstream probing.
+func DeepHealthHandler(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "healthy"})
+}
@@ -0,0 +1,181 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/nightingale-health/api-gateway/cache"
+	"github.com/nightingale-health/api-gateway/proxy"
+	"github.com/nightingale-health/api-gateway/redisclient"
+	"github.com/nightingale-health/api-gateway/schemadrift"
+	"github.com/nightingale-health/api-gateway/serialization"
+)
+
+const patientCacheTTL = 2 * time.Minute
+
+// PatientSchema and NotesSchema describe the fields PatientHandler expects
+// in the backend's patient and clinical-note responses. They're passed to
+// schemadrift.NewDetector so a backend contract change shows up as counted
+// drift instead of a client-visible failure.
+var PatientSchema = schemadrift.Schema{
+	"id":            schemadrift.KindNumber,
+	"mrn":           schemadrift.KindString,
+	"first_name":    schemadrift.KindString,
+	"last_name":     schemadrift.KindString,
+	"date_of_birth": schemadrift.KindString,
+}
+
+var NotesSchema = schemadrift.Schema{
+	"note_id": schemadrift.KindNumber,
+	"body":    schemadrift.KindString,
+	"version": schemadrift.KindNumber,
+}
+
+// PatientHandler serves patient reads from the backend, cached in Redis.
+// GetPatient reads through a two-tier local+Redis cache (cache.TwoTier) for
+// hot keys, and a stampede guard (cache.StampedeGuard) so a cache miss for a
+// popular patient sends exactly one request upstream instead of one per
+// concurrent caller.
+type PatientHandler struct {
+	redis    *redisclient.RedisClient
+	cache    *cache.TwoTier
+	stampede *cache.StampedeGuard
+	proxy    *proxy.Proxy
+	baseURL  string
+	drift    *schemadrift.Detector
+}
+
+// NewPatientHandler constructs a PatientHandler backed by the given Redis
+// client and proxy, forwarding to baseURL for cache misses. drift may be
+// nil, in which case upstream responses are relayed without a schema check.
+func NewPatientHandler(redis *redisclient.RedisClient, p *proxy.Proxy, baseURL string, drift *schemadrift.Detector) *PatientHandler {
+	rdb := redis.Raw()
+	return &PatientHandler{
+		redis:    redis,
+		cache:    cache.NewTwoTier(rdb, 0, patientCacheTTL),
+		stampede: cache.NewStampedeGuard(rdb),
+		proxy:    p,
+		baseURL:  baseURL,
+		drift:    drift,
+	}
+}
+
+func (h *PatientHandler) checkDrift(schemaName string, body []byte) {
+	if h.drift != nil {
+		h.drift.Check(schemaName, body)
+	}
+}
+
+// Cache returns the handler's local+Redis cache, so the invalidation bus
+// and admin cache-clear endpoint can drop entries from the same instance
+// PatientHandler reads through.
+func (h *PatientHandler) Cache() *cache.TwoTier {
+	return h.cache
+}
+
+// upstreamStatusError carries a non-200 upstream response through
+// StampedeGuard.GetOrRecompute, which otherwise only distinguishes
+// success/failure, so GetPatient can still pass the real status and body
+// back to the caller instead of collapsing every non-200 into a 502.
+type upstreamStatusError struct {
+	status int
+	body   []byte
+}
+
+func (e *upstreamStatusError) Error() string {
+	return http.StatusText(e.status)
+}
+
+// GetPatient returns a single patient by ID, serving from cache when
+// possible.
+func (h *PatientHandler) GetPatient(c *gin.Context) {
+	id := c.Param("id")
+	cacheKey := "patient:" + id
+	ctx := c.Request.Context()
+
+	if cached, ok := h.cache.Get(ctx, cacheKey); ok {
+		h.writePatient(c, http.StatusOK, []byte(cached))
+		return
+	}
+
+	body, err := h.stampede.GetOrRecompute(ctx, cacheKey, patientCacheTTL, func() (string, error) {
+		resp, err := h.proxy.ForwardRequest(http.MethodGet, h.baseURL, "/patients/"+id, c.Request.Header, nil)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return "", err
+		}
+		if resp.StatusCode != http.StatusOK {
+			// Not cached: only successful lookups are worth remembering.
+			return "", &upstreamStatusError{status: resp.StatusCode, body: data}
+		}
+		h.checkDrift("patient", data)
+		return string(data), nil
+	})
+	if err != nil {
+		var statusErr *upstreamStatusError
+		if errors.As(err, &statusErr) {
+			c.Data(statusErr.status, "application/json", statusErr.body)
+			return
+		}
+		c.JSON(http.StatusBadGateway, gin.H{"error": gin.H{"code": "GATEWAY_502", "message": "upstream unavailable"}})
+		return
+	}
+
+	h.cache.Set(ctx, cacheKey, body, patientCacheTTL)
+	h.writePatient(c, http.StatusOK, []byte(body))
+}
+
+// writePatient sends a successful patient body in whatever format the
+// caller's Accept header negotiates (JSON, XML, or FHIR); a body that fails
+// to parse is relayed as-is so a malformed upstream response still reaches
+// the caller instead of being swallowed by a conversion error.
+func (h *PatientHandler) writePatient(c *gin.Context, status int, body []byte) {
+	format := serialization.Negotiate(c.GetHeader("Accept"))
+	out, contentType, err := serialization.EncodePatient(body, format)
+	if err != nil {
+		c.Data(status, "application/json", body)
+		return
+	}
+	c.Data(status, contentType, out)
+}
+
+// UploadInsuranceDocument is not yet implemented; see BT-05-059. The route
+// is already mounted behind middleware.ReplayProtection so a captured
+// upload request can't be resubmitted once this is filled in.
+func (h *PatientHandler) UploadInsuranceDocument(c *gin.Context) {
+	c.Status(http.StatusNotImplemented)
+}
+
+// GetClinicalNote proxies to the backend's clinical-note endpoint.
+func (h *PatientHandler) GetClinicalNote(c *gin.Context) {
+	id := c.Param("id")
+	resp, err := h.proxy.ForwardRequest(http.MethodGet, h.baseURL, "/clinical/notes/"+id, c.Request.Header, nil)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": gin.H{"code": "GATEWAY_502", "message": "upstream unavailable"}})
+		return
+	}
+	defer resp.Body.Close()
+
+	if h.drift == nil || resp.StatusCode != http.StatusOK {
+		c.DataFromReader(resp.StatusCode, resp.ContentLength, resp.Header.Get("Content-Type"), resp.Body, nil)
+		return
+	}
+
+	// Schema checking needs the whole body in hand, so a configured
+	// detector trades the streaming response for a buffered one.
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": gin.H{"code": "GATEWAY_502", "message": "upstream unavailable"}})
+		return
+	}
+	h.checkDrift("notes", data)
+	c.Data(resp.StatusCode, resp.Header.Get("Content-Type"), data)
+}
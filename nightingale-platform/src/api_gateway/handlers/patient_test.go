@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+
+	"github.com/nightingale-health/api-gateway/proxy"
+	"github.com/nightingale-health/api-gateway/redisclient"
+	"github.com/nightingale-health/api-gateway/schemadrift"
+	"github.com/sirupsen/logrus"
+)
+
+func newTestPatientHandler(t *testing.T, backendURL string) *PatientHandler {
+	t.Helper()
+	return newTestPatientHandlerWithDrift(t, backendURL, nil)
+}
+
+func newTestPatientHandlerWithDrift(t *testing.T, backendURL string, drift *schemadrift.Detector) *PatientHandler {
+	t.Helper()
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	t.Cleanup(mr.Close)
+	redisClient := redisclient.NewRedisClient(mr.Addr(), "", 0)
+	return NewPatientHandler(redisClient, proxy.NewProxy(0), backendURL, drift)
+}
+
+func TestPatientHandler_GetPatient_PopulatesCacheOnMiss(t *testing.T) {
+	var upstreamCalls int64
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(&upstreamCalls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"p1","name":"Jane Doe"}`))
+	}))
+	defer backend.Close()
+
+	h := newTestPatientHandler(t, backend.URL)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/patients/:id", h.GetPatient)
+
+	for i := 0; i < 3; i++ {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/patients/p1", nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("request %d: status = %d, want 200: %s", i, w.Code, w.Body.String())
+		}
+		if !strings.Contains(w.Body.String(), "Jane Doe") {
+			t.Fatalf("request %d: body = %s, want Jane Doe", i, w.Body.String())
+		}
+	}
+
+	if calls := atomic.LoadInt64(&upstreamCalls); calls != 1 {
+		t.Fatalf("upstream called %d times, want exactly 1 — cache miss was not written back", calls)
+	}
+}
+
+func TestPatientHandler_GetPatient_DoesNotCacheNonOKStatus(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"not found"}`))
+	}))
+	defer backend.Close()
+
+	h := newTestPatientHandler(t, backend.URL)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/patients/:id", h.GetPatient)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/patients/missing", nil))
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want 404 passed through from upstream: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestPatientHandler_GetClinicalNote_UsesSlashSeparatedPath(t *testing.T) {
+	var gotPath string
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	defer backend.Close()
+
+	h := newTestPatientHandler(t, backend.URL)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/patients/:id/clinical-note", h.GetClinicalNote)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/patients/abc123/clinical-note", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+	if gotPath != "/clinical/notes/abc123" {
+		t.Fatalf("backend received path %q, want /clinical/notes/abc123", gotPath)
+	}
+}
+
+func TestPatientHandler_GetPatient_CountsSchemaDriftWithoutFailingRequest(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 1, "first_name": "Jane"}`))
+	}))
+	defer backend.Close()
+
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	drift := schemadrift.NewDetector(log, map[string]schemadrift.Schema{"patient": PatientSchema})
+
+	h := newTestPatientHandlerWithDrift(t, backend.URL, drift)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/patients/:id", h.GetPatient)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/patients/p1", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200 even though the response is missing fields: %s", w.Code, w.Body.String())
+	}
+
+	counts := drift.Counts()
+	if counts["patient"] == 0 {
+		t.Fatalf("counts = %+v, want nonzero drift for missing mrn/last_name/date_of_birth", counts)
+	}
+}
+
+func TestPatientHandler_GetPatient_NegotiatesFHIRContentType(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":7,"mrn":"MRN-7","first_name":"Jane","last_name":"Doe","date_of_birth":"1990-01-02"}`))
+	}))
+	defer backend.Close()
+
+	h := newTestPatientHandler(t, backend.URL)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/patients/:id", h.GetPatient)
+
+	req := httptest.NewRequest(http.MethodGet, "/patients/7", nil)
+	req.Header.Set("Accept", "application/fhir+json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/fhir+json" {
+		t.Fatalf("Content-Type = %q, want application/fhir+json", ct)
+	}
+	if !strings.Contains(w.Body.String(), `"resourceType":"Patient"`) {
+		t.Fatalf("body = %s, want a FHIR Patient resource", w.Body.String())
+	}
+}
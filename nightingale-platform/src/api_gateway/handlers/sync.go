@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/nightingale-health/api-gateway/proxy"
+)
+
+// SyncHandler serves /api/v1/sync, letting the mobile app reconcile an
+// offline cache of patients, appointments, and messages in one round trip.
+type SyncHandler struct {
+	proxy   *proxy.Proxy
+	baseURL string
+}
+
+// NewSyncHandler constructs a SyncHandler forwarding to the backend at
+// baseURL.
+func NewSyncHandler(p *proxy.Proxy, baseURL string) *SyncHandler {
+	return &SyncHandler{proxy: p, baseURL: baseURL}
+}
+
+// syncResponse is the envelope returned by /api/v1/sync: the changes since
+// the caller's watermark, plus a new watermark to present next time.
+type syncResponse struct {
+	Watermark   string        `json:"watermark"`
+	Patients    []syncRecord  `json:"patients"`
+	Appointments []syncRecord `json:"appointments"`
+	Messages    []syncRecord  `json:"messages"`
+}
+
+// syncRecord represents either a live record or, when Deleted is true, a
+// tombstone for a record the client should remove from its offline cache.
+type syncRecord struct {
+	ID      string          `json:"id"`
+	Deleted bool            `json:"deleted"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// Sync returns every patient, appointment, and message change (including
+// deletions, as tombstones) relevant to the caller since the watermark
+// supplied in the `since` query parameter. An empty or missing watermark
+// returns a full snapshot.
+func (h *SyncHandler) Sync(c *gin.Context) {
+	since := c.Query("since")
+
+	resp, err := h.proxy.ForwardRequest(http.MethodGet, h.baseURL, "/sync?since="+since, c.Request.Header, nil)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": gin.H{"code": "GATEWAY_502", "message": "upstream unavailable"}})
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		c.DataFromReader(resp.StatusCode, resp.ContentLength, resp.Header.Get("Content-Type"), resp.Body, nil)
+		return
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": gin.H{"code": "GATEWAY_502", "message": "upstream read failed"}})
+		return
+	}
+
+	var upstream syncResponse
+	if err := json.Unmarshal(body, &upstream); err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": gin.H{"code": "GATEWAY_502", "message": "malformed sync response"}})
+		return
+	}
+
+	c.JSON(http.StatusOK, upstream)
+}
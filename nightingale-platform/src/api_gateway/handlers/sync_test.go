@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/nightingale-health/api-gateway/proxy"
+)
+
+func TestSyncHandler_ReturnsDeltasAndTombstonesSinceWatermark(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.RawQuery, "since=watermark-1") {
+			t.Errorf("backend received query %q, want since=watermark-1", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"watermark": "watermark-2",
+			"patients": [{"id": "p1", "deleted": false, "data": {"name": "Jane Doe"}}],
+			"appointments": [{"id": "a1", "deleted": true}],
+			"messages": []
+		}`))
+	}))
+	defer backend.Close()
+
+	h := NewSyncHandler(proxy.NewProxy(0), backend.URL)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/api/v1/sync", h.Sync)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/sync?since=watermark-1", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+	body := w.Body.String()
+	if !strings.Contains(body, "watermark-2") {
+		t.Errorf("response missing new watermark: %s", body)
+	}
+	if !strings.Contains(body, `"deleted":true`) {
+		t.Errorf("response missing tombstone for deleted appointment: %s", body)
+	}
+}
@@ -0,0 +1,109 @@
+package logging
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/nightingale-health/api-gateway/config"
+)
+
+const defaultMaxSizeBytes = 100 * 1024 * 1024
+
+// fileHook writes each log entry as a JSON line to a local file, rotating
+// it once it grows past MaxSizeBytes. Rotated files are numbered
+// path.1, path.2, ... up to MaxBackups; the oldest is removed to make room
+// for a new one.
+type fileHook struct {
+	cfg       config.FileSinkConfig
+	formatter logrus.Formatter
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+func newFileHook(cfg config.FileSinkConfig) (*fileHook, error) {
+	if cfg.Path == "" {
+		return nil, fmt.Errorf("path is required")
+	}
+	if cfg.MaxSizeBytes <= 0 {
+		cfg.MaxSizeBytes = defaultMaxSizeBytes
+	}
+	if cfg.MaxBackups <= 0 {
+		cfg.MaxBackups = 5
+	}
+
+	h := &fileHook{cfg: cfg, formatter: &logrus.JSONFormatter{}}
+	if err := h.open(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func (h *fileHook) open() error {
+	f, err := os.OpenFile(h.cfg.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	h.file = f
+	h.size = info.Size()
+	return nil
+}
+
+func (h *fileHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *fileHook) Fire(entry *logrus.Entry) error {
+	line, err := h.formatter.Format(entry)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.size+int64(len(line)) > h.cfg.MaxSizeBytes {
+		if err := h.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := h.file.Write(line)
+	h.size += int64(n)
+	return err
+}
+
+// rotate shifts path.N to path.N+1 (dropping anything past MaxBackups) and
+// starts a fresh file at path.
+func (h *fileHook) rotate() error {
+	h.file.Close()
+
+	for n := h.cfg.MaxBackups - 1; n >= 1; n-- {
+		src := fmt.Sprintf("%s.%d", h.cfg.Path, n)
+		dst := fmt.Sprintf("%s.%d", h.cfg.Path, n+1)
+		if _, err := os.Stat(src); err == nil {
+			os.Rename(src, dst)
+		}
+	}
+	if h.cfg.MaxBackups > 0 {
+		os.Rename(h.cfg.Path, fmt.Sprintf("%s.1", h.cfg.Path))
+	}
+	return h.open()
+}
+
+// Close flushes and closes the underlying file.
+func (h *fileHook) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.file != nil {
+		h.file.Close()
+	}
+}
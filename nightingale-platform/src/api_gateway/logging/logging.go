@@ -0,0 +1,61 @@
+// Package logging builds the gateway's shared access logger, attaching any
+// configured sinks (rotating file, syslog, Loki push) as logrus hooks so
+// every caller logs through the same *logrus.Logger instance instead of
+// constructing its own.
+package logging
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/nightingale-health/api-gateway/config"
+)
+
+// New builds the shared access logger for cfg. The returned closer stops
+// any background workers started for sinks (e.g. the Loki push pool) and
+// must be called when the gateway shuts down; it is always safe to call
+// even if no such sinks were enabled.
+func New(cfg config.LoggingConfig) (*logrus.Logger, func(), error) {
+	log := logrus.New()
+	log.SetFormatter(&logrus.JSONFormatter{})
+
+	if level, err := logrus.ParseLevel(cfg.Level); err == nil {
+		log.SetLevel(level)
+	} else if cfg.Level != "" {
+		return nil, nil, fmt.Errorf("logging: invalid level %q: %w", cfg.Level, err)
+	}
+
+	var closers []func()
+
+	if cfg.File.Enabled {
+		hook, err := newFileHook(cfg.File)
+		if err != nil {
+			return nil, nil, fmt.Errorf("logging: file sink: %w", err)
+		}
+		log.AddHook(hook)
+		closers = append(closers, hook.Close)
+	}
+
+	if cfg.Syslog.Enabled {
+		hook, err := newSyslogHook(cfg.Syslog)
+		if err != nil {
+			return nil, nil, fmt.Errorf("logging: syslog sink: %w", err)
+		}
+		log.AddHook(hook)
+		closers = append(closers, hook.Close)
+	}
+
+	if cfg.Loki.Enabled {
+		hook := newLokiHook(cfg.Loki)
+		log.AddHook(hook)
+		closers = append(closers, hook.Close)
+	}
+
+	closeAll := func() {
+		for _, c := range closers {
+			c()
+		}
+	}
+	return log, closeAll, nil
+}
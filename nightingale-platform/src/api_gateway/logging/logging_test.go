@@ -0,0 +1,52 @@
+package logging
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nightingale-health/api-gateway/config"
+)
+
+func TestFileSinkRotatesAtMaxSize(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "access.log")
+
+	log, closeLogging, err := New(config.LoggingConfig{
+		Level: "info",
+		File: config.FileSinkConfig{
+			Enabled:      true,
+			Path:         path,
+			MaxSizeBytes: 200,
+			MaxBackups:   2,
+		},
+	})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer closeLogging()
+
+	for i := 0; i < 20; i++ {
+		log.WithField("n", i).Info("request handled")
+	}
+
+	if _, err := os.Stat(path + ".1"); err != nil {
+		t.Fatalf("expected a rotated backup file: %v", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open active log: %v", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry map[string]interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("line is not valid JSON: %v", err)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/nightingale-health/api-gateway/config"
+)
+
+// lokiPushBody is the minimal shape of Loki's HTTP push API request body:
+// https://grafana.com/docs/loki/latest/reference/loki-http-api/#push-log-entries-to-loki
+type lokiPushBody struct {
+	Streams []lokiStream `json:"streams"`
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+// lokiHook pushes each log entry to a Loki instance over HTTP. Pushes run
+// on a bounded worker pool so a slow or unreachable Loki endpoint can never
+// add latency to the request that produced the log entry; once the queue
+// fills, further entries are dropped.
+type lokiHook struct {
+	cfg    config.LokiSinkConfig
+	client *http.Client
+	lines  chan string
+	done   chan struct{}
+}
+
+func newLokiHook(cfg config.LokiSinkConfig) *lokiHook {
+	queueSize := cfg.QueueSize
+	if queueSize <= 0 {
+		queueSize = 256
+	}
+	workers := cfg.WorkerPoolSize
+	if workers <= 0 {
+		workers = 2
+	}
+
+	h := &lokiHook{
+		cfg:    cfg,
+		client: &http.Client{Timeout: 5 * time.Second},
+		lines:  make(chan string, queueSize),
+		done:   make(chan struct{}),
+	}
+	for i := 0; i < workers; i++ {
+		go h.worker()
+	}
+	return h
+}
+
+func (h *lokiHook) worker() {
+	for {
+		select {
+		case line := <-h.lines:
+			h.push(line)
+		case <-h.done:
+			return
+		}
+	}
+}
+
+func (h *lokiHook) push(line string) {
+	body := lokiPushBody{Streams: []lokiStream{{
+		Stream: h.cfg.Labels,
+		Values: [][2]string{{strconv.FormatInt(time.Now().UnixNano(), 10), line}},
+	}}}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return
+	}
+
+	req, err := http.NewRequest(http.MethodPost, h.cfg.PushURL, bytes.NewReader(payload))
+	if err != nil {
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+}
+
+func (h *lokiHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *lokiHook) Fire(entry *logrus.Entry) error {
+	formatted, err := entry.String()
+	if err != nil {
+		return fmt.Errorf("loki hook: format entry: %w", err)
+	}
+
+	select {
+	case h.lines <- formatted:
+	default:
+		// Queue full: drop rather than block the logging call site.
+	}
+	return nil
+}
+
+// Close stops the worker pool.
+func (h *lokiHook) Close() {
+	close(h.done)
+}
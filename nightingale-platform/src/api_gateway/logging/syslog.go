@@ -0,0 +1,55 @@
+package logging
+
+import (
+	"log/syslog"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/nightingale-health/api-gateway/config"
+)
+
+// syslogHook forwards each log entry's formatted message to a syslog
+// daemon at a severity mapped from the entry's logrus level.
+type syslogHook struct {
+	writer *syslog.Writer
+}
+
+func newSyslogHook(cfg config.SyslogSinkConfig) (*syslogHook, error) {
+	tag := cfg.Tag
+	if tag == "" {
+		tag = "api-gateway"
+	}
+	w, err := syslog.Dial(cfg.Network, cfg.Addr, syslog.LOG_INFO|syslog.LOG_LOCAL0, tag)
+	if err != nil {
+		return nil, err
+	}
+	return &syslogHook{writer: w}, nil
+}
+
+func (h *syslogHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *syslogHook) Fire(entry *logrus.Entry) error {
+	line, err := entry.String()
+	if err != nil {
+		return err
+	}
+	switch entry.Level {
+	case logrus.PanicLevel, logrus.FatalLevel:
+		return h.writer.Crit(line)
+	case logrus.ErrorLevel:
+		return h.writer.Err(line)
+	case logrus.WarnLevel:
+		return h.writer.Warning(line)
+	case logrus.DebugLevel, logrus.TraceLevel:
+		return h.writer.Debug(line)
+	default:
+		return h.writer.Info(line)
+	}
+}
+
+// Close closes the underlying syslog connection.
+func (h *syslogHook) Close() {
+	h.writer.Close()
+}
@@ -0,0 +1,131 @@
+// Command api-gateway runs the Nightingale API Gateway: JWT validation,
+// rate limiting, caching, and reverse proxying to backend services.
+package main
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/nightingale-health/api-gateway/cache"
+	"github.com/nightingale-health/api-gateway/config"
+	"github.com/nightingale-health/api-gateway/handlers"
+	"github.com/nightingale-health/api-gateway/logging"
+	"github.com/nightingale-health/api-gateway/middleware"
+	"github.com/nightingale-health/api-gateway/proxy"
+	"github.com/nightingale-health/api-gateway/quota"
+	"github.com/nightingale-health/api-gateway/redisclient"
+	"github.com/nightingale-health/api-gateway/schemadrift"
+)
+
+// cacheInvalidationChannel is the Redis pub/sub channel gateway replicas use
+// to tell each other when to drop locally-cached patient data.
+const cacheInvalidationChannel = "cache:invalidate"
+
+func loadConfig() config.Config {
+	return config.Config{
+		Server: config.ServerConfig{
+			ListenAddr:      ":8080",
+			ShutdownTimeout: 10 * time.Second,
+		},
+		JWT: config.JWTConfig{
+			Secret:   "change-me",
+			Issuer:   "nightingale",
+			Audience: "nightingale-api-audience",
+		},
+		Redis: config.RedisConfig{
+			Addr: "localhost:6379",
+		},
+		RateLimit: config.RateLimitConfig{
+			RequestsPerMinute: 60,
+			Burst:             10,
+			Window:            time.Minute,
+		},
+		TokenBucket: config.TokenBucketConfig{
+			Capacity:        20,
+			RefillPerSecond: 1,
+			LocalBatch:      5,
+		},
+		Quota: config.QuotaConfig{
+			DailyLimit:   1000,
+			MonthlyLimit: 20000,
+		},
+		Logging: config.LoggingConfig{
+			Level: "info",
+		},
+		ShadowMirror: config.ShadowMirrorConfig{
+			Enabled: false,
+		},
+		Upstreams: map[string]config.UpstreamConfig{
+			"backend": {BaseURL: "http://uhr-data-store:8090", Timeout: 30 * time.Second},
+			"ai":      {BaseURL: "http://model-serving:8091", Timeout: 30 * time.Second},
+		},
+	}
+}
+
+func main() {
+	cfg := loadConfig()
+	log, closeLogging, err := logging.New(cfg.Logging)
+	if err != nil {
+		panic(err)
+	}
+	defer closeLogging()
+
+	redisClient := redisclient.NewRedisClientFromConfig(cfg.Redis)
+	p := proxy.NewProxy(cfg.Upstreams["backend"].Timeout)
+	schemaDetector := schemadrift.NewDetector(log, map[string]schemadrift.Schema{
+		"patient": handlers.PatientSchema,
+		"notes":   handlers.NotesSchema,
+	})
+	patientHandler := handlers.NewPatientHandler(redisClient, p, cfg.Upstreams["backend"].BaseURL, schemaDetector)
+	aiProxy := handlers.NewAIProxy(p, cfg.Upstreams["ai"].BaseURL)
+	syncHandler := handlers.NewSyncHandler(p, cfg.Upstreams["backend"].BaseURL)
+	rotationTracker := &middleware.RotationTracker{}
+	slidingLimiter := middleware.NewSlidingWindowLimiter(redisClient.Raw(), cfg.RateLimit.Window, cfg.RateLimit.RequestsPerMinute)
+	usageQuota := quota.NewQuota(redisClient.Raw(), quota.Limits{Daily: cfg.Quota.DailyLimit, Monthly: cfg.Quota.MonthlyLimit})
+	aiTokenBucket := middleware.NewTokenBucketLimiter(redisClient.Raw(), cfg.TokenBucket.Capacity, cfg.TokenBucket.RefillPerSecond, cfg.TokenBucket.LocalBatch)
+	allowlist := middleware.NewAllowlist(redisClient.Raw())
+
+	invalidationBus := cache.NewInvalidationBus(redisClient.Raw(), cacheInvalidationChannel, log)
+	invalidationBus.Subscribe(func(key string) {
+		if key == "*" {
+			patientHandler.Cache().Clear()
+			return
+		}
+		patientHandler.Cache().InvalidateLocal(key)
+	})
+	defer invalidationBus.Close()
+
+	adminHandler := handlers.NewAdminHandler(rotationTracker, slidingLimiter, redisClient, patientHandler.Cache(), invalidationBus, schemaDetector, usageQuota, allowlist)
+	shadowMirror := middleware.NewShadowMirror(cfg.ShadowMirror, log)
+	defer shadowMirror.Close()
+
+	r := gin.New()
+	r.Use(middleware.Logger(log))
+	r.Use(middleware.AllowlistMiddleware(allowlist))
+	r.Use(middleware.SlidingWindowMiddleware(slidingLimiter))
+	r.Use(middleware.JWTAuth(cfg.JWT, rotationTracker))
+	r.Use(middleware.AllowlistMiddleware(allowlist))
+	r.Use(shadowMirror.Middleware())
+
+	r.GET("/health/deep", handlers.DeepHealthHandler)
+	r.GET("/patients/:id", patientHandler.GetPatient)
+	r.GET("/patients/:id/clinical-note", patientHandler.GetClinicalNote)
+	r.POST("/patients/:id/insurance-document", middleware.ReplayProtection(redisClient.Raw()), patientHandler.UploadInsuranceDocument)
+	r.POST("/ai/summarize", middleware.TokenBucketMiddleware(aiTokenBucket), middleware.QuotaMiddleware(usageQuota, middleware.ContentLengthCost(10, 500)), aiProxy.SummarizeClinicalNote)
+	r.POST("/ai/explain", middleware.TokenBucketMiddleware(aiTokenBucket), middleware.QuotaMiddleware(usageQuota, middleware.ContentLengthCost(2, 200)), aiProxy.ExplainMedicalTerm)
+	r.GET("/api/v1/sync", syncHandler.Sync)
+	admin := r.Group("/admin", middleware.RequireAdmin())
+	admin.POST("/cache/clear", adminHandler.ClearCache)
+	admin.GET("/jwt/rotation-status", adminHandler.JWTRotationStatus)
+	admin.GET("/rate-limits", adminHandler.GetRateLimitState)
+	admin.DELETE("/rate-limits/:key", adminHandler.ResetRateLimit)
+	admin.GET("/schema-drift", adminHandler.SchemaDrift)
+	admin.GET("/quotas/:caller", adminHandler.GetQuota)
+	admin.PUT("/quotas/:caller", adminHandler.SetQuota)
+	admin.GET("/rate-limit-exemptions", adminHandler.ListRateLimitExemptions)
+	admin.POST("/rate-limit-exemptions", adminHandler.AddRateLimitExemption)
+	admin.DELETE("/rate-limit-exemptions/:entry", adminHandler.RemoveRateLimitExemption)
+
+	r.Run(cfg.Server.ListenAddr)
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// RequireAdmin returns a gin middleware that rejects requests whose
+// validated JWT claims (set by JWTAuth) do not carry the "admin" role. It
+// must run after JWTAuth so "claims" is already populated in the context.
+func RequireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, ok := c.Get("claims")
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": gin.H{"code": "GATEWAY_403", "message": "admin role required"}})
+			return
+		}
+		mapClaims, ok := claims.(jwt.MapClaims)
+		if !ok || mapClaims["role"] != "admin" {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": gin.H{"code": "GATEWAY_403", "message": "admin role required"}})
+			return
+		}
+		c.Next()
+	}
+}
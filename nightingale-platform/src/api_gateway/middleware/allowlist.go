@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+// allowlistKey is the Redis set every replica and the admin API read and
+// write, so an exemption takes effect everywhere at once.
+const allowlistKey = "ratelimit:allowlist"
+
+// Allowlist is a set of callers — IPs, user IDs, or API keys — exempt from
+// rate limiting and quota enforcement, for internal monitoring, load
+// tests, and trusted hospital integrations that must never be throttled.
+type Allowlist struct {
+	rdb redis.UniversalClient
+}
+
+// NewAllowlist constructs an Allowlist backed by rdb.
+func NewAllowlist(rdb redis.UniversalClient) *Allowlist {
+	return &Allowlist{rdb: rdb}
+}
+
+// Add exempts entry from rate limiting.
+func (a *Allowlist) Add(ctx context.Context, entry string) error {
+	return a.rdb.SAdd(ctx, allowlistKey, entry).Err()
+}
+
+// Remove revokes entry's exemption.
+func (a *Allowlist) Remove(ctx context.Context, entry string) error {
+	return a.rdb.SRem(ctx, allowlistKey, entry).Err()
+}
+
+// List returns every exempt entry.
+func (a *Allowlist) List(ctx context.Context) ([]string, error) {
+	return a.rdb.SMembers(ctx, allowlistKey).Result()
+}
+
+// isAnyExempt reports whether any of candidates is on the allowlist,
+// checking all of them in a single round trip.
+func (a *Allowlist) isAnyExempt(ctx context.Context, candidates []string) (bool, error) {
+	members := make([]interface{}, len(candidates))
+	for i, c := range candidates {
+		members[i] = c
+	}
+	hits, err := a.rdb.SMIsMember(ctx, allowlistKey, members...).Result()
+	if err != nil {
+		return false, err
+	}
+	for _, hit := range hits {
+		if hit {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// rateLimitExemptKey is the gin context key AllowlistMiddleware sets once
+// it finds a match, so every rate limiter and quota middleware mounted
+// after it can skip straight past Redis for a caller that must never be
+// throttled.
+const rateLimitExemptKey = "rate_limit_exempt"
+
+// AllowlistMiddleware checks the caller's IP, X-API-Key header, and (once
+// JWTAuth has run) JWT "sub" claim against allowlist, marking the request
+// exempt from every limiter mounted after it if any of them match.
+//
+// This is mounted twice in main.go: once before JWTAuth, so IP- and
+// API-key-based exemptions cover the global sliding-window limiter, and
+// once after, so a user-ID exemption also covers the per-route quota and
+// token-bucket checks. A request already marked exempt by the first pass
+// isn't re-checked. allowlist may be nil, in which case this is a no-op.
+func AllowlistMiddleware(allowlist *Allowlist) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if allowlist == nil || c.GetBool(rateLimitExemptKey) {
+			c.Next()
+			return
+		}
+
+		candidates := []string{c.ClientIP()}
+		if apiKey := c.GetHeader("X-API-Key"); apiKey != "" {
+			candidates = append(candidates, apiKey)
+		}
+		if claims, ok := c.Get("claims"); ok {
+			if mapClaims, ok := claims.(jwt.MapClaims); ok {
+				if sub, ok := mapClaims["sub"].(string); ok && sub != "" {
+					candidates = append(candidates, sub)
+				}
+			}
+		}
+
+		if exempt, err := allowlist.isAnyExempt(c.Request.Context(), candidates); err == nil && exempt {
+			c.Set(rateLimitExemptKey, true)
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestAllowlistMiddleware_ExemptsMatchedIPFromSlidingWindow(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	defer mr.Close()
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+
+	allowlist := NewAllowlist(rdb)
+	if err := allowlist.Add(context.Background(), "192.0.2.1"); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	limiter := NewSlidingWindowLimiter(rdb, time.Minute, 1)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(AllowlistMiddleware(allowlist))
+	r.Use(SlidingWindowMiddleware(limiter))
+	r.GET("/x", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	for i := 0; i < 5; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/x", nil)
+		req.RemoteAddr = "192.0.2.1:1234"
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("request %d: status = %d, want 200 (exempt caller should never be throttled)", i, w.Code)
+		}
+	}
+}
+
+func TestAllowlistMiddleware_DoesNotExemptUnlistedCaller(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	defer mr.Close()
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+
+	allowlist := NewAllowlist(rdb)
+	limiter := NewSlidingWindowLimiter(rdb, time.Minute, 1)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(AllowlistMiddleware(allowlist))
+	r.Use(SlidingWindowMiddleware(limiter))
+	r.GET("/x", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	newReq := func() *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/x", nil)
+		req.RemoteAddr = "192.0.2.2:1234"
+		return req
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, newReq())
+	if w.Code != http.StatusOK {
+		t.Fatalf("first request status = %d, want 200", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, newReq())
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("second request status = %d, want 429 (non-exempt caller should be throttled)", w.Code)
+	}
+}
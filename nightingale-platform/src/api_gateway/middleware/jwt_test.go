@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/nightingale-health/api-gateway/config"
+)
+
+func signToken(t *testing.T, secret string) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": "user-1",
+	})
+	signed, err := token.SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return signed
+}
+
+func TestParseWithRotation_AcceptsBothSecretsDuringWindow(t *testing.T) {
+	cfg := config.JWTConfig{
+		Secret:           "new-secret",
+		PreviousSecret:   "old-secret",
+		RotationDeadline: time.Now().Add(time.Hour),
+	}
+
+	_, usedPrevious, err := parseWithRotation("Bearer "+signToken(t, "new-secret"), cfg)
+	if err != nil || usedPrevious {
+		t.Fatalf("new secret: usedPrevious=%v err=%v, want false/nil", usedPrevious, err)
+	}
+
+	_, usedPrevious, err = parseWithRotation("Bearer "+signToken(t, "old-secret"), cfg)
+	if err != nil || !usedPrevious {
+		t.Fatalf("old secret: usedPrevious=%v err=%v, want true/nil", usedPrevious, err)
+	}
+}
+
+func TestParseWithRotation_RejectsPreviousSecretAfterDeadline(t *testing.T) {
+	cfg := config.JWTConfig{
+		Secret:           "new-secret",
+		PreviousSecret:   "old-secret",
+		RotationDeadline: time.Now().Add(-time.Hour),
+	}
+
+	_, _, err := parseWithRotation("Bearer "+signToken(t, "old-secret"), cfg)
+	if err == nil {
+		t.Fatal("expected old secret to be rejected once the rotation deadline has passed")
+	}
+}
+
+func TestRotationTracker_ReportsAccurateFraction(t *testing.T) {
+	tracker := &RotationTracker{}
+	tracker.Observe(false)
+	tracker.Observe(false)
+	tracker.Observe(false)
+	tracker.Observe(true)
+
+	fraction, current, previous := tracker.Status()
+	if current != 3 || previous != 1 {
+		t.Fatalf("current=%d previous=%d, want 3/1", current, previous)
+	}
+	if fraction != 0.25 {
+		t.Fatalf("fraction=%v, want 0.25", fraction)
+	}
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+// Logger returns a gin middleware that records one structured access-log
+// entry per request using the shared logger instance.
+func Logger(log *logrus.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		log.WithFields(logrus.Fields{
+			"requestId":    c.GetString("requestId"),
+			"clientIp":     c.ClientIP(),
+			"endpoint":     c.Request.URL.Path,
+			"responseCode": c.Writer.Status(),
+			"latency":      time.Since(start).String(),
+		}).Info("request handled")
+	}
+}
@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/nightingale-health/api-gateway/quota"
+)
+
+// CostFunc computes how many quota units a request should consume, so
+// routes that are far more expensive than a plain read (e.g. an AI
+// summarize call) can draw down a caller's budget faster than a cheap one.
+// A nil CostFunc, or one returning less than 1, falls back to a cost of 1.
+type CostFunc func(c *gin.Context) int64
+
+// ContentLengthCost builds a CostFunc that charges baseCost units plus one
+// additional unit per bytesPerUnit bytes of request body, a rough proxy for
+// input token count without having to buffer and re-stream the body (which
+// would be wasted work for requests that are simply forwarded upstream).
+// bytesPerUnit <= 0 disables the size-based component, charging baseCost
+// for every request.
+func ContentLengthCost(baseCost, bytesPerUnit int64) CostFunc {
+	return func(c *gin.Context) int64 {
+		if bytesPerUnit <= 0 {
+			return baseCost
+		}
+		length := c.Request.ContentLength
+		if length <= 0 {
+			return baseCost
+		}
+		return baseCost + length/bytesPerUnit
+	}
+}
+
+// QuotaMiddleware returns a gin middleware enforcing q's daily/monthly
+// usage limits against the caller, identified by the validated JWT's "sub"
+// claim (set by JWTAuth, which must run before this middleware). This is a
+// separate concern from the sliding-window rate limiter: a clinic can be
+// well within its per-minute burst limit and still have exhausted the
+// usage its contract covers for the day or month.
+//
+// A caller with no "sub" claim is tracked under "anonymous", matching
+// ReplayProtection's fallback. cost may be nil for a flat cost of 1 unit
+// per request.
+func QuotaMiddleware(q *quota.Quota, cost CostFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetBool(rateLimitExemptKey) {
+			c.Next()
+			return
+		}
+
+		callerID := "anonymous"
+		if claims, ok := c.Get("claims"); ok {
+			if mapClaims, ok := claims.(jwt.MapClaims); ok {
+				if sub, ok := mapClaims["sub"].(string); ok && sub != "" {
+					callerID = sub
+				}
+			}
+		}
+
+		weight := int64(1)
+		if cost != nil {
+			if w := cost(c); w > weight {
+				weight = w
+			}
+		}
+
+		usage, err := q.CheckN(c.Request.Context(), callerID, time.Now(), weight)
+		if err != nil {
+			// Redis unavailable: fail open rather than blocking every
+			// request on a long-horizon quota we can't currently check.
+			c.Next()
+			return
+		}
+
+		switch usage.Exhausted {
+		case "daily":
+			c.Header("Retry-After", "86400")
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"error": gin.H{
+					"code":        "GATEWAY_429",
+					"message":     "Daily usage quota exhausted. Try again tomorrow.",
+					"retry_after": 86400,
+				},
+			})
+			return
+		case "monthly":
+			c.AbortWithStatusJSON(http.StatusPaymentRequired, gin.H{
+				"error": gin.H{
+					"code":    "GATEWAY_402",
+					"message": "Monthly usage quota exhausted for this billing period.",
+				},
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
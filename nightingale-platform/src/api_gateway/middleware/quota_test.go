@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/nightingale-health/api-gateway/quota"
+)
+
+func newTestQuotaRouter(t *testing.T, limits quota.Limits) *gin.Engine {
+	t.Helper()
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	t.Cleanup(mr.Close)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+
+	q := quota.NewQuota(rdb, limits)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Set("claims", jwt.MapClaims{"sub": "clinic-1"})
+		c.Next()
+	})
+	r.Use(QuotaMiddleware(q, nil))
+	r.GET("/ai/summarize", func(c *gin.Context) { c.Status(http.StatusOK) })
+	return r
+}
+
+func TestQuotaMiddleware_RejectsWithTooManyRequestsWhenDailyExhausted(t *testing.T) {
+	r := newTestQuotaRouter(t, quota.Limits{Daily: 1, Monthly: 100})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ai/summarize", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("first request status = %d, want 200", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ai/summarize", nil))
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("second request status = %d, want 429: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestQuotaMiddleware_CostFuncChargesMoreThanOneUnitPerRequest(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	t.Cleanup(mr.Close)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+
+	q := quota.NewQuota(rdb, quota.Limits{Daily: 10, Monthly: 1000})
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Set("claims", jwt.MapClaims{"sub": "clinic-1"})
+		c.Next()
+	})
+	r.Use(QuotaMiddleware(q, ContentLengthCost(5, 1)))
+	r.POST("/ai/summarize", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	// Daily limit is 10; a 5-unit base cost means the third request (15
+	// units) should be rejected even though only 3 requests were made.
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/ai/summarize", nil))
+		if w.Code != http.StatusOK {
+			t.Fatalf("request %d: status = %d, want 200", i, w.Code)
+		}
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/ai/summarize", nil))
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("third request status = %d, want 429 (2*5=10 already used the full daily budget): %s", w.Code, w.Body.String())
+	}
+}
+
+func TestQuotaMiddleware_RejectsWithPaymentRequiredWhenMonthlyExhausted(t *testing.T) {
+	r := newTestQuotaRouter(t, quota.Limits{Daily: 100, Monthly: 1})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ai/summarize", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("first request status = %d, want 200", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/ai/summarize", nil))
+	if w.Code != http.StatusPaymentRequired {
+		t.Fatalf("second request status = %d, want 402: %s", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+
+	"github.com/nightingale-health/api-gateway/redisclient"
+)
+
+// RateLimitMiddleware enforces a fixed-window per-key request limit backed
+// by Redis INCR+EXPIRE. This allows bursts across window boundaries and
+// races under concurrent requests; see BT-05-015 for the sliding-window
+// replacement.
+func RateLimitMiddleware(redisClient *redisclient.RedisClient, requestsPerMinute int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := "ratelimit:" + c.ClientIP()
+		ctx := c.Request.Context()
+
+		count, err := redisClient.Raw().Incr(ctx, key).Result()
+		if err != nil {
+			// Redis unavailable: fall back to an in-process limiter.
+			fallbackRateLimit(c, requestsPerMinute)
+			return
+		}
+		if count == 1 {
+			redisClient.Raw().Expire(ctx, key, time.Minute)
+		}
+
+		if count > int64(requestsPerMinute) {
+			c.Header("Retry-After", "60")
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"error": gin.H{
+					"code":        "GATEWAY_429",
+					"message":     "Rate limit exceeded. Please try again later.",
+					"retry_after": 60,
+				},
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// fallbackLimiters holds one rate.Limiter per caller, reused across calls
+// so its burst bucket actually depletes; a fresh limiter on every request
+// always has a full bucket and never throttles anything.
+var fallbackLimiters sync.Map // string -> *rate.Limiter
+
+// fallbackRateLimit is used when Redis is unreachable. It keys a
+// process-local limiter by client IP, so it still enforces requestsPerMinute
+// per caller even though, unlike the Redis-backed limiters it stands in
+// for, its state isn't shared across replicas.
+func fallbackRateLimit(c *gin.Context, requestsPerMinute int) {
+	limiterIface, _ := fallbackLimiters.LoadOrStore(c.ClientIP(), rate.NewLimiter(rate.Limit(requestsPerMinute)/60, requestsPerMinute))
+	limiter := limiterIface.(*rate.Limiter)
+	if !limiter.Allow() {
+		c.Header("Retry-After", "60")
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+			"error": gin.H{
+				"code":        "GATEWAY_429",
+				"message":     "Rate limit exceeded. Please try again later.",
+				"retry_after": 60,
+			},
+		})
+		return
+	}
+	c.Next()
+}
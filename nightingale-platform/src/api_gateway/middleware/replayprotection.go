@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+// replayProtectionWindow bounds how far a request's timestamp may drift
+// from the gateway's clock before it's rejected outright, and doubles as
+// the TTL on the nonce record: a replay can't be submitted after its
+// timestamp has aged out anyway, so there's no need to remember the nonce
+// any longer than that.
+const replayProtectionWindow = 5 * time.Minute
+
+// ReplayProtection returns a gin middleware that rejects a request whose
+// (caller, nonce) pair has already been seen, guarding payment and claims
+// submission routes against a captured request being resubmitted to
+// double-charge or double-submit a claim.
+//
+// Callers must send an X-Request-Nonce header (a client-generated unique
+// value) and an X-Request-Timestamp header (Unix seconds). The caller
+// identity is read from the validated JWT's "sub" claim, set by JWTAuth,
+// which must run before this middleware.
+func ReplayProtection(rdb redis.UniversalClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		nonce := c.GetHeader("X-Request-Nonce")
+		timestampHeader := c.GetHeader("X-Request-Timestamp")
+		if nonce == "" || timestampHeader == "" {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "GATEWAY_400", "message": "X-Request-Nonce and X-Request-Timestamp headers are required"}})
+			return
+		}
+
+		timestamp, err := strconv.ParseInt(timestampHeader, 10, 64)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "GATEWAY_400", "message": "X-Request-Timestamp must be Unix seconds"}})
+			return
+		}
+		age := time.Since(time.Unix(timestamp, 0))
+		if age < -replayProtectionWindow || age > replayProtectionWindow {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": gin.H{"code": "GATEWAY_400", "message": "X-Request-Timestamp is outside the acceptable window"}})
+			return
+		}
+
+		callerID := "anonymous"
+		if claims, ok := c.Get("claims"); ok {
+			if mapClaims, ok := claims.(jwt.MapClaims); ok {
+				if sub, ok := mapClaims["sub"].(string); ok && sub != "" {
+					callerID = sub
+				}
+			}
+		}
+
+		key := fmt.Sprintf("replay:%s:%s", callerID, nonce)
+		set, err := rdb.SetNX(c.Request.Context(), key, timestampHeader, replayProtectionWindow).Result()
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadGateway, gin.H{"error": gin.H{"code": "GATEWAY_502", "message": "failed to verify request nonce"}})
+			return
+		}
+		if !set {
+			c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": gin.H{"code": "GATEWAY_409", "message": "request already processed: nonce has been seen before"}})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestReplayRouter(t *testing.T) *gin.Engine {
+	t.Helper()
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	t.Cleanup(mr.Close)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.POST("/claims", ReplayProtection(rdb), func(c *gin.Context) {
+		c.Status(http.StatusCreated)
+	})
+	return r
+}
+
+func TestReplayProtection_RejectsRepeatedNonce(t *testing.T) {
+	r := newTestReplayRouter(t)
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req := httptest.NewRequest(http.MethodPost, "/claims", nil)
+	req.Header.Set("X-Request-Nonce", "nonce-1")
+	req.Header.Set("X-Request-Timestamp", ts)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("first request status = %d, want 201: %s", w.Code, w.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/claims", nil)
+	req.Header.Set("X-Request-Nonce", "nonce-1")
+	req.Header.Set("X-Request-Timestamp", ts)
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusConflict {
+		t.Fatalf("replayed request status = %d, want 409: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestReplayProtection_RejectsStaleTimestamp(t *testing.T) {
+	r := newTestReplayRouter(t)
+	stale := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+
+	req := httptest.NewRequest(http.MethodPost, "/claims", nil)
+	req.Header.Set("X-Request-Nonce", "nonce-2")
+	req.Header.Set("X-Request-Timestamp", stale)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("stale-timestamp status = %d, want 400: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestReplayProtection_RejectsMissingHeaders(t *testing.T) {
+	r := newTestReplayRouter(t)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/claims", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400 for missing headers: %s", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,172 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/nightingale-health/api-gateway/config"
+)
+
+// phiScrubPatterns redacts common PHI shapes (SSNs, MRNs, emails) from a
+// mirrored request body before it leaves the gateway. This is a best-effort
+// scrub for the shadow copy only; it never touches the body forwarded to the
+// real upstream.
+var phiScrubPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`\b\d{3}-\d{2}-\d{4}\b`),            // SSN
+	regexp.MustCompile(`(?i)"mrn"\s*:\s*"[^"]*"`),           // MRN field
+	regexp.MustCompile(`[\w.+-]+@[\w-]+\.[\w.-]+`),          // email
+}
+
+func scrubPHI(body []byte) []byte {
+	out := body
+	for _, pattern := range phiScrubPatterns {
+		out = pattern.ReplaceAll(out, []byte("[REDACTED]"))
+	}
+	return out
+}
+
+type mirrorJob struct {
+	method string
+	url    string
+	header http.Header
+	body   []byte
+}
+
+// ShadowMirror returns a gin middleware that asynchronously duplicates a
+// sampled fraction of requests, after PHI scrubbing, to a configured shadow
+// upstream. Mirroring never blocks or alters the client-facing response: the
+// request body is read and restored for the real handler chain, and the
+// mirror job is dispatched onto a bounded worker pool so a slow or
+// unreachable shadow target cannot add client latency.
+//
+// Callers must call Close when the gateway shuts down to stop the worker
+// pool. Close does not wait for in-flight sends, and jobs still queued when
+// it is called may be dropped.
+type ShadowMirror struct {
+	cfg    config.ShadowMirrorConfig
+	log    *logrus.Logger
+	client *http.Client
+	jobs   chan mirrorJob
+	done   chan struct{}
+}
+
+// NewShadowMirror constructs a ShadowMirror and starts its worker pool. If
+// mirroring is disabled, the returned middleware is a cheap no-op and no
+// workers are started.
+func NewShadowMirror(cfg config.ShadowMirrorConfig, log *logrus.Logger) *ShadowMirror {
+	sm := &ShadowMirror{
+		cfg: cfg,
+		log: log,
+		client: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+		done: make(chan struct{}),
+	}
+	if !cfg.Enabled {
+		return sm
+	}
+
+	queueSize := cfg.QueueSize
+	if queueSize <= 0 {
+		queueSize = 256
+	}
+	workers := cfg.WorkerPoolSize
+	if workers <= 0 {
+		workers = 4
+	}
+	sm.jobs = make(chan mirrorJob, queueSize)
+	for i := 0; i < workers; i++ {
+		go sm.worker()
+	}
+	return sm
+}
+
+func (sm *ShadowMirror) worker() {
+	for {
+		select {
+		case job := <-sm.jobs:
+			sm.send(job)
+		case <-sm.done:
+			return
+		}
+	}
+}
+
+func (sm *ShadowMirror) send(job mirrorJob) {
+	ctx, cancel := context.WithTimeout(context.Background(), sm.client.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, job.method, sm.cfg.TargetURL+job.url, bytes.NewReader(job.body))
+	if err != nil {
+		sm.log.WithError(err).Warn("shadow mirror: failed to build request")
+		return
+	}
+	req.Header = job.header.Clone()
+
+	resp, err := sm.client.Do(req)
+	if err != nil {
+		sm.log.WithError(err).Debug("shadow mirror: upstream unreachable")
+		return
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+}
+
+// Middleware returns the gin.HandlerFunc that samples and enqueues mirror
+// jobs for matching requests.
+func (sm *ShadowMirror) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !sm.cfg.Enabled || sm.jobs == nil || !shouldSample(sm.cfg.SampleRate) {
+			c.Next()
+			return
+		}
+
+		var bodyCopy []byte
+		if c.Request.Body != nil {
+			bodyCopy, _ = io.ReadAll(c.Request.Body)
+			c.Request.Body = io.NopCloser(bytes.NewReader(bodyCopy))
+		}
+
+		job := mirrorJob{
+			method: c.Request.Method,
+			url:    c.Request.URL.RequestURI(),
+			header: c.Request.Header.Clone(),
+			body:   scrubPHI(bodyCopy),
+		}
+
+		select {
+		case sm.jobs <- job:
+		default:
+			// Queue full: drop the mirror job rather than block the
+			// client-facing request.
+		}
+
+		c.Next()
+	}
+}
+
+// Close stops the worker pool. Safe to call even when mirroring was never
+// enabled.
+func (sm *ShadowMirror) Close() {
+	if sm.jobs != nil {
+		close(sm.done)
+	}
+}
+
+func shouldSample(rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	if rate >= 1 {
+		return true
+	}
+	return rand.Float64() < rate
+}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+
+	"github.com/nightingale-health/api-gateway/config"
+)
+
+func TestScrubPHI(t *testing.T) {
+	in := []byte(`{"ssn":"123-45-6789","mrn":"MRN-9001","email":"pat@example.com","note":"ok"}`)
+	out := scrubPHI(in)
+
+	for _, leaked := range []string{"123-45-6789", "MRN-9001", "pat@example.com"} {
+		if strings.Contains(string(out), leaked) {
+			t.Errorf("scrubPHI left PHI %q in output: %s", leaked, out)
+		}
+	}
+	if !strings.Contains(string(out), "ok") {
+		t.Errorf("scrubPHI dropped non-PHI content: %s", out)
+	}
+}
+
+func TestShadowMirror_ForwardsScrubbedCopyWithoutBlockingClient(t *testing.T) {
+	var received int32
+	shadow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := make([]byte, r.ContentLength)
+		r.Body.Read(body)
+		if strings.Contains(string(body), "123-45-6789") {
+			t.Errorf("shadow upstream received unscrubbed PHI: %s", body)
+		}
+		atomic.AddInt32(&received, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer shadow.Close()
+
+	sm := NewShadowMirror(config.ShadowMirrorConfig{
+		Enabled:        true,
+		SampleRate:     1.0,
+		TargetURL:      shadow.URL,
+		WorkerPoolSize: 2,
+		QueueSize:      8,
+	}, logrus.New())
+	defer sm.Close()
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(sm.Middleware())
+	r.POST("/patients", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/patients", strings.NewReader(`{"ssn":"123-45-6789"}`))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("client response code = %d, want 200", w.Code)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for atomic.LoadInt32(&received) == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if atomic.LoadInt32(&received) == 0 {
+		t.Fatal("shadow upstream never received a mirrored request")
+	}
+}
+
+func TestShadowMirror_DisabledIsNoop(t *testing.T) {
+	sm := NewShadowMirror(config.ShadowMirrorConfig{Enabled: false}, logrus.New())
+	defer sm.Close()
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(sm.Middleware())
+	r.GET("/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("client response code = %d, want 200", w.Code)
+	}
+}
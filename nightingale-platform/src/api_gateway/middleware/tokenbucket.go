@@ -0,0 +1,159 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+)
+
+// tokenBucketScript atomically refills a per-key bucket in Redis and
+// withdraws up to ARGV[4] tokens from it, so every gateway replica draws
+// from the same shared bucket instead of keeping its own independent one
+// (which is what let SlidingWindowLimiter's in-memory fallback diverge
+// across replicas). A replica withdraws a small batch at once — see
+// TokenBucketLimiter.localBatch — rather than one token per request, so
+// most requests are served from the replica's local reservoir without a
+// Redis round trip.
+//
+// KEYS[1]: the bucket's hash key
+// ARGV[1]: bucket capacity
+// ARGV[2]: refill rate in tokens per second
+// ARGV[3]: current time in milliseconds
+// ARGV[4]: requested tokens (the local batch size)
+// ARGV[5]: key TTL in seconds, long enough that an idle bucket is dropped
+//
+//	rather than held onto forever
+//
+// Returns the number of tokens granted (0 if the bucket is empty).
+const tokenBucketScript = `
+local key = KEYS[1]
+local capacity = tonumber(ARGV[1])
+local refillRate = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local requested = tonumber(ARGV[4])
+local ttl = tonumber(ARGV[5])
+
+local data = redis.call('HMGET', key, 'tokens', 'ts')
+local tokens = tonumber(data[1])
+local ts = tonumber(data[2])
+if tokens == nil then
+  tokens = capacity
+  ts = now
+end
+
+local elapsedSeconds = math.max(0, now - ts) / 1000.0
+tokens = math.min(capacity, tokens + elapsedSeconds * refillRate)
+
+local granted = math.min(requested, math.floor(tokens))
+tokens = tokens - granted
+
+redis.call('HMSET', key, 'tokens', tokens, 'ts', now)
+redis.call('EXPIRE', key, ttl)
+
+return granted
+`
+
+// TokenBucketLimiter is a Redis-backed token bucket shared across every
+// gateway replica, so the limit holds regardless of which replica a
+// caller's requests land on. Each replica reserves a small local batch of
+// tokens per Redis round trip (localBatch) and serves requests from that
+// reservoir until it's spent, smoothing bursts without a Redis call on
+// every single request.
+type TokenBucketLimiter struct {
+	rdb        redis.UniversalClient
+	capacity   int64
+	refillRate float64
+	localBatch int64
+
+	mu         sync.Mutex
+	reservoirs map[string]int64
+}
+
+// NewTokenBucketLimiter constructs a limiter whose shared bucket holds at
+// most capacity tokens, refilling at refillRate tokens/second. localBatch
+// is how many tokens a replica reserves from the shared bucket at once.
+func NewTokenBucketLimiter(rdb redis.UniversalClient, capacity int64, refillRate float64, localBatch int64) *TokenBucketLimiter {
+	return &TokenBucketLimiter{
+		rdb:        rdb,
+		capacity:   capacity,
+		refillRate: refillRate,
+		localBatch: localBatch,
+		reservoirs: make(map[string]int64),
+	}
+}
+
+// Allow reports whether a request for key may proceed, withdrawing from
+// the local reservoir first and only falling through to Redis once it's
+// empty.
+func (l *TokenBucketLimiter) Allow(ctx context.Context, key string) (bool, error) {
+	l.mu.Lock()
+	if l.reservoirs[key] > 0 {
+		l.reservoirs[key]--
+		l.mu.Unlock()
+		return true, nil
+	}
+	l.mu.Unlock()
+
+	ttlSeconds := int64((time.Duration(float64(time.Second) * float64(l.capacity) / l.refillRate)) / time.Second)
+	if ttlSeconds < 1 {
+		ttlSeconds = 1
+	}
+	reply, err := l.rdb.Eval(ctx, tokenBucketScript, []string{key},
+		l.capacity, l.refillRate, time.Now().UnixMilli(), l.localBatch, ttlSeconds,
+	).Result()
+	if err != nil {
+		return false, err
+	}
+	granted := toInt64(reply)
+	if granted == 0 {
+		return false, nil
+	}
+
+	l.mu.Lock()
+	l.reservoirs[key] += granted - 1
+	l.mu.Unlock()
+	return true, nil
+}
+
+// Key builds the Redis key for a given caller identifier.
+func (l *TokenBucketLimiter) Key(caller string) string {
+	return "ratelimit:tokenbucket:" + caller
+}
+
+// TokenBucketMiddleware returns a gin middleware enforcing l against the
+// caller's client IP. If Redis is unreachable it falls back to the
+// process-local limiter shared with SlidingWindowMiddleware.
+func TokenBucketMiddleware(l *TokenBucketLimiter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetBool(rateLimitExemptKey) {
+			c.Next()
+			return
+		}
+		allowed, err := l.Allow(c.Request.Context(), l.Key(c.ClientIP()))
+		if err != nil {
+			fallbackRateLimit(c, int(l.refillRate*60))
+			return
+		}
+		if !allowed {
+			retrySeconds := int(1 / l.refillRate)
+			if retrySeconds < 1 {
+				retrySeconds = 1
+			}
+			c.Header("Retry-After", fmt.Sprintf("%d", retrySeconds))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"error": gin.H{
+					"code":        "GATEWAY_429",
+					"message":     "Rate limit exceeded. Please try again later.",
+					"retry_after": retrySeconds,
+				},
+			})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestTokenBucket(t *testing.T, capacity int64, refillRate float64, localBatch int64) *TokenBucketLimiter {
+	t.Helper()
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	t.Cleanup(mr.Close)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	return NewTokenBucketLimiter(rdb, capacity, refillRate, localBatch)
+}
+
+func TestTokenBucketLimiter_AdmitsUpToCapacityThenRejects(t *testing.T) {
+	l := newTestTokenBucket(t, 5, 1, 2)
+	ctx := context.Background()
+
+	admitted := 0
+	for i := 0; i < 10; i++ {
+		ok, err := l.Allow(ctx, "caller-1")
+		if err != nil {
+			t.Fatalf("Allow: %v", err)
+		}
+		if ok {
+			admitted++
+		}
+	}
+	if admitted != 5 {
+		t.Fatalf("admitted = %d, want 5 (bucket capacity)", admitted)
+	}
+}
+
+func TestTokenBucketLimiter_SharesStateAcrossInstances(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	defer mr.Close()
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer rdb.Close()
+
+	// Two limiter instances model two gateway replicas sharing one Redis
+	// bucket; each reserves its own local batch, but the total admitted
+	// across both must still respect the shared capacity.
+	replicaA := NewTokenBucketLimiter(rdb, 4, 1, 2)
+	replicaB := NewTokenBucketLimiter(rdb, 4, 1, 2)
+
+	ctx := context.Background()
+	admitted := 0
+	for i := 0; i < 3; i++ {
+		if ok, err := replicaA.Allow(ctx, "caller-2"); err != nil {
+			t.Fatalf("Allow: %v", err)
+		} else if ok {
+			admitted++
+		}
+		if ok, err := replicaB.Allow(ctx, "caller-2"); err != nil {
+			t.Fatalf("Allow: %v", err)
+		} else if ok {
+			admitted++
+		}
+	}
+	if admitted != 4 {
+		t.Fatalf("admitted = %d across both replicas, want 4 (shared capacity)", admitted)
+	}
+}
+
+func TestTokenBucketLimiter_RefillsOverTime(t *testing.T) {
+	l := newTestTokenBucket(t, 2, 100, 1)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if ok, err := l.Allow(ctx, "caller-3"); err != nil || !ok {
+			t.Fatalf("request %d: ok=%v err=%v, want admitted", i, ok, err)
+		}
+	}
+	if ok, _ := l.Allow(ctx, "caller-3"); ok {
+		t.Fatalf("bucket should be empty immediately after exhausting capacity")
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	if ok, err := l.Allow(ctx, "caller-3"); err != nil || !ok {
+		t.Fatalf("after refill: ok=%v err=%v, want admitted", ok, err)
+	}
+}
@@ -0,0 +1,40 @@
+// Package proxy forwards gateway requests to backend upstreams.
+package proxy
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/nightingale-health/api-gateway/config"
+)
+
+// Proxy forwards incoming requests to a configured backend.
+type Proxy struct {
+	client *http.Client
+}
+
+// NewProxy constructs a Proxy with the given per-call timeout.
+func NewProxy(timeout time.Duration) *Proxy {
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	return &Proxy{client: &http.Client{Timeout: timeout}}
+}
+
+// ForwardRequest sends method+body to the upstream at baseURL+path and
+// returns the upstream's response for the caller to relay.
+func (p *Proxy) ForwardRequest(method, baseURL, path string, header http.Header, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequest(method, baseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header = header.Clone()
+	return p.client.Do(req)
+}
+
+// Upstream looks up the configured backend for a logical upstream name.
+func Upstream(cfg map[string]config.UpstreamConfig, name string) (config.UpstreamConfig, bool) {
+	u, ok := cfg[name]
+	return u, ok
+}
@@ -0,0 +1,213 @@
+// Package quota tracks long-horizon (daily/monthly) usage per caller,
+// separate from the short-window rate limiters in middleware: a caller can
+// be well within its sliding-window limit yet still have exhausted the
+// usage its clinic contracted for this month.
+package quota
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// quotaScript atomically checks and increments a caller's daily and
+// monthly counters against its limits. A limit of 0 means unlimited.
+// Running both checks and both increments in one script avoids a caller
+// slipping through on the daily check and then failing the monthly
+// increment (or vice versa) under concurrent requests.
+//
+// KEYS[1]: daily counter key
+// KEYS[2]: monthly counter key
+// ARGV[1]: daily limit (0 = unlimited)
+// ARGV[2]: monthly limit (0 = unlimited)
+// ARGV[3]: daily key TTL in seconds
+// ARGV[4]: monthly key TTL in seconds
+// ARGV[5]: cost to charge this request, in units (>= 1)
+//
+// Returns {allowed (0/1), exhausted ("daily"/"monthly"/""), daily count,
+// monthly count}.
+const quotaScript = `
+local dailyKey = KEYS[1]
+local monthlyKey = KEYS[2]
+local dailyLimit = tonumber(ARGV[1])
+local monthlyLimit = tonumber(ARGV[2])
+local dailyTTL = tonumber(ARGV[3])
+local monthlyTTL = tonumber(ARGV[4])
+local cost = tonumber(ARGV[5])
+
+local daily = tonumber(redis.call('GET', dailyKey) or '0')
+local monthly = tonumber(redis.call('GET', monthlyKey) or '0')
+
+if dailyLimit > 0 and daily + cost > dailyLimit then
+  return {0, 'daily', daily, monthly}
+end
+if monthlyLimit > 0 and monthly + cost > monthlyLimit then
+  return {0, 'monthly', daily, monthly}
+end
+
+daily = redis.call('INCRBY', dailyKey, cost)
+if daily == cost then
+  redis.call('EXPIRE', dailyKey, dailyTTL)
+end
+monthly = redis.call('INCRBY', monthlyKey, cost)
+if monthly == cost then
+  redis.call('EXPIRE', monthlyKey, monthlyTTL)
+end
+
+return {1, '', daily, monthly}
+`
+
+// Limits is a caller's daily and monthly allowance. A zero field means
+// unlimited for that horizon.
+type Limits struct {
+	Daily   int64
+	Monthly int64
+}
+
+// Usage is a caller's current counters alongside the limits they were
+// checked against.
+type Usage struct {
+	Daily     int64
+	Monthly   int64
+	Limits    Limits
+	Exhausted string // "daily", "monthly", or "" if the request was admitted
+}
+
+// Quota tracks per-caller usage in Redis, keyed by day and by calendar
+// month so counters roll over on their own without a background job.
+type Quota struct {
+	rdb           redis.UniversalClient
+	defaultLimits Limits
+}
+
+// NewQuota constructs a Quota backed by rdb. defaultLimits apply to any
+// caller without an explicit override set via SetLimits.
+func NewQuota(rdb redis.UniversalClient, defaultLimits Limits) *Quota {
+	return &Quota{rdb: rdb, defaultLimits: defaultLimits}
+}
+
+func dailyKey(caller string, now time.Time) string {
+	return fmt.Sprintf("quota:daily:%s:%s", caller, now.Format("2006-01-02"))
+}
+
+func monthlyKey(caller string, now time.Time) string {
+	return fmt.Sprintf("quota:monthly:%s:%s", caller, now.Format("2006-01"))
+}
+
+func limitsKey(caller string) string {
+	return "quota:limits:" + caller
+}
+
+// Check evaluates and, if admitted, increments caller's daily and monthly
+// counters at time now by a cost of 1 unit. Limits come from an admin
+// override if one has been set via SetLimits, otherwise the Quota's default
+// limits.
+func (q *Quota) Check(ctx context.Context, caller string, now time.Time) (Usage, error) {
+	return q.CheckN(ctx, caller, now, 1)
+}
+
+// CheckN is Check with a caller-supplied cost, for routes whose requests
+// don't all draw down a caller's budget equally (e.g. an AI summarize call
+// that costs far more than a patient read). cost is clamped to at least 1
+// so a misconfigured or zero-valued weight can't bypass the quota entirely.
+func (q *Quota) CheckN(ctx context.Context, caller string, now time.Time, cost int64) (Usage, error) {
+	if cost < 1 {
+		cost = 1
+	}
+
+	limits, err := q.Limits(ctx, caller)
+	if err != nil {
+		return Usage{}, err
+	}
+
+	// Counters are keyed by date, so a new day or month starts a fresh key;
+	// the TTLs only need to outlive their period (with about a day's grace)
+	// so that past counters expire on their own.
+	dailyTTL := int64((25 * time.Hour).Seconds())
+	monthlyTTL := int64((32 * 24 * time.Hour).Seconds())
+
+	reply, err := q.rdb.Eval(ctx, quotaScript,
+		[]string{dailyKey(caller, now), monthlyKey(caller, now)},
+		limits.Daily, limits.Monthly, dailyTTL, monthlyTTL, cost,
+	).Result()
+	if err != nil {
+		return Usage{}, err
+	}
+
+	vals, ok := reply.([]interface{})
+	if !ok || len(vals) != 4 {
+		return Usage{}, fmt.Errorf("unexpected quota script reply: %#v", reply)
+	}
+	return Usage{
+		Exhausted: fmt.Sprintf("%v", vals[1]),
+		Daily:     toInt64(vals[2]),
+		Monthly:   toInt64(vals[3]),
+		Limits:    limits,
+	}, nil
+}
+
+// Limits returns caller's effective limits: its admin override if one
+// exists, otherwise the Quota's default.
+func (q *Quota) Limits(ctx context.Context, caller string) (Limits, error) {
+	vals, err := q.rdb.HGetAll(ctx, limitsKey(caller)).Result()
+	if err != nil {
+		return Limits{}, err
+	}
+	if len(vals) == 0 {
+		return q.defaultLimits, nil
+	}
+	limits := q.defaultLimits
+	if v, ok := vals["daily"]; ok {
+		limits.Daily = parseInt64(v)
+	}
+	if v, ok := vals["monthly"]; ok {
+		limits.Monthly = parseInt64(v)
+	}
+	return limits, nil
+}
+
+// SetLimits overrides caller's daily/monthly limits, replacing any earlier
+// override. Used by the admin quota-adjustment endpoint.
+func (q *Quota) SetLimits(ctx context.Context, caller string, limits Limits) error {
+	return q.rdb.HSet(ctx, limitsKey(caller), map[string]interface{}{
+		"daily":   limits.Daily,
+		"monthly": limits.Monthly,
+	}).Err()
+}
+
+// Usage returns caller's current counters at time now without admitting a
+// request, for the admin quota-inspection endpoint.
+func (q *Quota) Usage(ctx context.Context, caller string, now time.Time) (Usage, error) {
+	limits, err := q.Limits(ctx, caller)
+	if err != nil {
+		return Usage{}, err
+	}
+	daily, err := q.rdb.Get(ctx, dailyKey(caller, now)).Result()
+	if err != nil && err != redis.Nil {
+		return Usage{}, err
+	}
+	monthly, err := q.rdb.Get(ctx, monthlyKey(caller, now)).Result()
+	if err != nil && err != redis.Nil {
+		return Usage{}, err
+	}
+	return Usage{Daily: parseInt64(daily), Monthly: parseInt64(monthly), Limits: limits}, nil
+}
+
+func toInt64(v interface{}) int64 {
+	switch t := v.(type) {
+	case int64:
+		return t
+	case string:
+		return parseInt64(t)
+	default:
+		return 0
+	}
+}
+
+func parseInt64(s string) int64 {
+	var n int64
+	fmt.Sscanf(s, "%d", &n)
+	return n
+}
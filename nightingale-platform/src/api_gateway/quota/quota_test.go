@@ -0,0 +1,132 @@
+package quota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newTestQuota(t *testing.T, limits Limits) *Quota {
+	t.Helper()
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	t.Cleanup(mr.Close)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	return NewQuota(rdb, limits)
+}
+
+func TestQuota_AdmitsUntilDailyLimitThenRejects(t *testing.T) {
+	q := newTestQuota(t, Limits{Daily: 2, Monthly: 100})
+	ctx := context.Background()
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		usage, err := q.Check(ctx, "clinic-1", now)
+		if err != nil {
+			t.Fatalf("Check: %v", err)
+		}
+		if usage.Exhausted != "" {
+			t.Fatalf("request %d: exhausted = %q, want admitted", i, usage.Exhausted)
+		}
+	}
+
+	usage, err := q.Check(ctx, "clinic-1", now)
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if usage.Exhausted != "daily" {
+		t.Fatalf("exhausted = %q, want daily", usage.Exhausted)
+	}
+}
+
+func TestQuota_MonthlyLimitAppliesAcrossDays(t *testing.T) {
+	q := newTestQuota(t, Limits{Daily: 100, Monthly: 1})
+	ctx := context.Background()
+	day1 := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2026, 1, 11, 0, 0, 0, 0, time.UTC)
+
+	if usage, err := q.Check(ctx, "clinic-2", day1); err != nil || usage.Exhausted != "" {
+		t.Fatalf("day1 check: usage=%+v err=%v, want admitted", usage, err)
+	}
+	usage, err := q.Check(ctx, "clinic-2", day2)
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	if usage.Exhausted != "monthly" {
+		t.Fatalf("exhausted = %q, want monthly even on a new day", usage.Exhausted)
+	}
+}
+
+func TestQuota_SetLimitsOverridesDefault(t *testing.T) {
+	q := newTestQuota(t, Limits{Daily: 1, Monthly: 1})
+	ctx := context.Background()
+	now := time.Now()
+
+	if err := q.SetLimits(ctx, "clinic-3", Limits{Daily: 5, Monthly: 50}); err != nil {
+		t.Fatalf("SetLimits: %v", err)
+	}
+
+	for i := 0; i < 5; i++ {
+		usage, err := q.Check(ctx, "clinic-3", now)
+		if err != nil {
+			t.Fatalf("Check: %v", err)
+		}
+		if usage.Exhausted != "" {
+			t.Fatalf("request %d: exhausted = %q, want admitted under overridden limit", i, usage.Exhausted)
+		}
+	}
+}
+
+func TestQuota_CheckNChargesTheGivenCost(t *testing.T) {
+	q := newTestQuota(t, Limits{Daily: 10, Monthly: 100})
+	ctx := context.Background()
+	now := time.Now()
+
+	usage, err := q.CheckN(ctx, "clinic-5", now, 7)
+	if err != nil {
+		t.Fatalf("CheckN: %v", err)
+	}
+	if usage.Exhausted != "" || usage.Daily != 7 {
+		t.Fatalf("usage = %+v, want admitted with daily=7", usage)
+	}
+
+	usage, err = q.CheckN(ctx, "clinic-5", now, 7)
+	if err != nil {
+		t.Fatalf("CheckN: %v", err)
+	}
+	if usage.Exhausted != "daily" {
+		t.Fatalf("exhausted = %q, want daily (7+7 > limit of 10)", usage.Exhausted)
+	}
+}
+
+func TestQuota_UsageReportsWithoutIncrementing(t *testing.T) {
+	q := newTestQuota(t, Limits{Daily: 10, Monthly: 100})
+	ctx := context.Background()
+	now := time.Now()
+
+	if _, err := q.Check(ctx, "clinic-4", now); err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+
+	usage, err := q.Usage(ctx, "clinic-4", now)
+	if err != nil {
+		t.Fatalf("Usage: %v", err)
+	}
+	if usage.Daily != 1 || usage.Monthly != 1 {
+		t.Fatalf("usage = %+v, want Daily=1 Monthly=1", usage)
+	}
+
+	usage, err = q.Usage(ctx, "clinic-4", now)
+	if err != nil {
+		t.Fatalf("Usage: %v", err)
+	}
+	if usage.Daily != 1 {
+		t.Fatalf("second Usage call incremented the counter: got %d, want 1", usage.Daily)
+	}
+}
@@ -0,0 +1,147 @@
+// Package redisclient wraps the gateway's Redis access so handlers and
+// middleware share one connection pool and one set of cache conventions.
+package redisclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/nightingale-health/api-gateway/config"
+)
+
+// RedisClient is a thin wrapper around a go-redis client. It holds a
+// redis.UniversalClient so the same wrapper works against a single node, a
+// Sentinel-backed failover deployment, or a Redis Cluster without callers
+// needing to know which.
+type RedisClient struct {
+	rdb redis.UniversalClient
+	ctx context.Context
+}
+
+// NewRedisClient connects to a single-node Redis instance at addr. Kept for
+// callers that don't need Sentinel/Cluster support; NewRedisClientFromConfig
+// is preferred for production wiring.
+func NewRedisClient(addr, password string, db int) *RedisClient {
+	return &RedisClient{
+		rdb: redis.NewClient(&redis.Options{
+			Addr:     addr,
+			Password: password,
+			DB:       db,
+		}),
+		ctx: context.Background(),
+	}
+}
+
+// NewRedisClientFromConfig builds a RedisClient from cfg, selecting single-
+// node, Sentinel-backed failover, or Cluster mode based on which fields are
+// populated:
+//   - cfg.Cluster.Enabled: Redis Cluster mode across cfg.Cluster.Addrs.
+//   - cfg.Sentinel.MasterName set: Sentinel-backed failover client using
+//     cfg.Sentinel.Addrs to locate the current master, with failover-aware
+//     retries on transient connection errors.
+//   - otherwise: a single-node client at cfg.Addr, as before.
+func NewRedisClientFromConfig(cfg config.RedisConfig) *RedisClient {
+	var rdb redis.UniversalClient
+
+	switch {
+	case cfg.Cluster.Enabled:
+		rdb = redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:    cfg.Cluster.Addrs,
+			Password: cfg.Password,
+			MaxRetries: 3,
+		})
+	case cfg.Sentinel.MasterName != "":
+		rdb = redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:    cfg.Sentinel.MasterName,
+			SentinelAddrs: cfg.Sentinel.Addrs,
+			Password:      cfg.Password,
+			DB:            cfg.DB,
+			MaxRetries:    3,
+		})
+	default:
+		rdb = redis.NewClient(&redis.Options{
+			Addr:       cfg.Addr,
+			Password:   cfg.Password,
+			DB:         cfg.DB,
+			MaxRetries: 3,
+		})
+	}
+
+	return &RedisClient{rdb: rdb, ctx: context.Background()}
+}
+
+// Raw exposes the underlying go-redis client for callers that need
+// operations this wrapper does not expose yet, such as EVAL-based limiters.
+func (r *RedisClient) Raw() redis.UniversalClient {
+	return r.rdb
+}
+
+// Get returns the value stored at key, or redis.Nil if it does not exist.
+func (r *RedisClient) Get(key string) (string, error) {
+	return r.rdb.Get(r.ctx, key).Result()
+}
+
+// Set stores value at key with the given TTL.
+func (r *RedisClient) Set(key, value string, ttl time.Duration) error {
+	return r.rdb.Set(r.ctx, key, value, ttl).Err()
+}
+
+// Delete removes key.
+func (r *RedisClient) Delete(key string) error {
+	return r.rdb.Del(r.ctx, key).Err()
+}
+
+// keysScanBatch is the COUNT hint passed to SCAN, i.e. roughly how many
+// keys Redis inspects per cursor step. It bounds how much work one SCAN
+// call does without blocking the event loop the way KEYS does.
+const keysScanBatch = 200
+
+// KeysWithPrefix returns every key under prefix using cursor-based SCAN
+// instead of the blocking KEYS command, so a large keyspace doesn't stall
+// the Redis event loop while this runs.
+func (r *RedisClient) KeysWithPrefix(prefix string) ([]string, error) {
+	var keys []string
+	var cursor uint64
+	for {
+		batch, next, err := r.rdb.Scan(r.ctx, cursor, prefix+"*", keysScanBatch).Result()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, batch...)
+		cursor = next
+		if cursor == 0 {
+			break
+		}
+	}
+	return keys, nil
+}
+
+// DeleteByPrefix removes every key under prefix. Keys are scanned with
+// KeysWithPrefix and deleted in batches with UNLINK, which reclaims memory
+// asynchronously instead of blocking on a large DEL.
+func (r *RedisClient) DeleteByPrefix(prefix string) error {
+	keys, err := r.KeysWithPrefix(prefix)
+	if err != nil {
+		return err
+	}
+	for len(keys) > 0 {
+		n := keysScanBatch
+		if n > len(keys) {
+			n = len(keys)
+		}
+		if err := r.rdb.Unlink(r.ctx, keys[:n]...).Err(); err != nil {
+			return err
+		}
+		keys = keys[n:]
+	}
+	return nil
+}
+
+// FlushAll clears the entire Redis keyspace. Used today as the only way the
+// admin API clears gateway caches; DeleteByPrefix is the prefix-scoped
+// alternative.
+func (r *RedisClient) FlushAll() error {
+	return r.rdb.FlushAll(r.ctx).Err()
+}
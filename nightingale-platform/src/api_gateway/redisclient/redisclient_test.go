@@ -0,0 +1,126 @@
+package redisclient
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/nightingale-health/api-gateway/config"
+)
+
+// These tests check which client type NewRedisClientFromConfig selects for a
+// given config. They don't exercise real Sentinel/Cluster failover (that
+// needs a live topology this sandbox doesn't have); they just pin the
+// selection logic so a future config field doesn't silently change which
+// mode a deployment ends up in.
+
+func TestNewRedisClientFromConfig_SelectsClusterWhenEnabled(t *testing.T) {
+	c := NewRedisClientFromConfig(config.RedisConfig{
+		Cluster: config.ClusterConfig{Enabled: true, Addrs: []string{"10.0.0.1:6379", "10.0.0.2:6379"}},
+	})
+
+	if _, ok := c.Raw().(*redis.ClusterClient); !ok {
+		t.Fatalf("expected *redis.ClusterClient, got %T", c.Raw())
+	}
+}
+
+func TestNewRedisClientFromConfig_SelectsSentinelWhenMasterNameSet(t *testing.T) {
+	c := NewRedisClientFromConfig(config.RedisConfig{
+		Sentinel: config.SentinelConfig{MasterName: "mymaster", Addrs: []string{"10.0.0.1:26379"}},
+	})
+
+	if _, ok := c.Raw().(*redis.Client); !ok {
+		t.Fatalf("expected *redis.Client (failover-backed), got %T", c.Raw())
+	}
+}
+
+func TestNewRedisClientFromConfig_SelectsSingleNodeByDefault(t *testing.T) {
+	c := NewRedisClientFromConfig(config.RedisConfig{Addr: "localhost:6379"})
+
+	if _, ok := c.Raw().(*redis.Client); !ok {
+		t.Fatalf("expected *redis.Client, got %T", c.Raw())
+	}
+}
+
+func TestNewRedisClientFromConfig_ClusterTakesPriorityOverSentinel(t *testing.T) {
+	c := NewRedisClientFromConfig(config.RedisConfig{
+		Cluster:  config.ClusterConfig{Enabled: true, Addrs: []string{"10.0.0.1:6379"}},
+		Sentinel: config.SentinelConfig{MasterName: "mymaster", Addrs: []string{"10.0.0.1:26379"}},
+	})
+
+	if _, ok := c.Raw().(*redis.ClusterClient); !ok {
+		t.Fatalf("expected cluster config to take priority, got %T", c.Raw())
+	}
+}
+
+func TestKeysWithPrefix_ScansPastOneBatch(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	defer mr.Close()
+
+	c := NewRedisClient(mr.Addr(), "", 0)
+	want := make([]string, 0, keysScanBatch+5)
+	for i := 0; i < keysScanBatch+5; i++ {
+		key := fmt.Sprintf("session:%04d", i)
+		if err := c.Set(key, "v", 0); err != nil {
+			t.Fatalf("Set: %v", err)
+		}
+		want = append(want, key)
+	}
+	if err := c.Set("other:1", "v", 0); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	got, err := c.KeysWithPrefix("session:")
+	if err != nil {
+		t.Fatalf("KeysWithPrefix: %v", err)
+	}
+	sort.Strings(got)
+	sort.Strings(want)
+	if len(got) != len(want) {
+		t.Fatalf("got %d keys, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("key %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestDeleteByPrefix_RemovesOnlyMatchingKeysAcrossBatches(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("start miniredis: %v", err)
+	}
+	defer mr.Close()
+
+	c := NewRedisClient(mr.Addr(), "", 0)
+	for i := 0; i < keysScanBatch+5; i++ {
+		if err := c.Set(fmt.Sprintf("session:%04d", i), "v", 0); err != nil {
+			t.Fatalf("Set: %v", err)
+		}
+	}
+	if err := c.Set("other:1", "v", 0); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	if err := c.DeleteByPrefix("session:"); err != nil {
+		t.Fatalf("DeleteByPrefix: %v", err)
+	}
+
+	remaining, err := c.KeysWithPrefix("session:")
+	if err != nil {
+		t.Fatalf("KeysWithPrefix: %v", err)
+	}
+	if len(remaining) != 0 {
+		t.Fatalf("expected no session: keys left, got %d", len(remaining))
+	}
+	if _, err := c.Get("other:1"); err != nil {
+		t.Fatalf("expected other:1 to survive DeleteByPrefix, got err: %v", err)
+	}
+}
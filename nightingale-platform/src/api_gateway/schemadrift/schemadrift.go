@@ -0,0 +1,118 @@
+// Package schemadrift detects when an upstream's JSON response no longer
+// matches the shape the gateway expects, without ever failing the request
+// that triggered the mismatch: drift here is a signal for operators to
+// investigate a backend contract change before it breaks a client, not a
+// reason to 502 a clinician mid-request.
+package schemadrift
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// FieldKind is the JSON value kind a schema expects a field to hold.
+type FieldKind int
+
+const (
+	KindString FieldKind = iota
+	KindNumber
+	KindBool
+	KindObject
+	KindArray
+)
+
+// Schema is the set of fields a JSON upstream response is expected to
+// contain, keyed by field name, with the JSON kind each should decode to.
+type Schema map[string]FieldKind
+
+// Detector validates upstream response bodies against named schemas,
+// logging and counting any missing field or kind mismatch it finds.
+type Detector struct {
+	log     *logrus.Logger
+	schemas map[string]Schema
+
+	mu     sync.Mutex
+	counts map[string]int64
+}
+
+// NewDetector constructs a Detector that checks responses against schemas,
+// keyed by the name handlers pass to Check (e.g. "patient", "notes").
+func NewDetector(log *logrus.Logger, schemas map[string]Schema) *Detector {
+	return &Detector{log: log, schemas: schemas, counts: make(map[string]int64)}
+}
+
+// Check validates body as a JSON object against the schema registered
+// under name. Unknown names are a no-op, so handlers can call Check
+// unconditionally even for resources that don't yet have a schema.
+func (d *Detector) Check(name string, body []byte) {
+	schema, ok := d.schemas[name]
+	if !ok {
+		return
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		d.log.WithField("schema", name).Warn("schema drift: response body is not a JSON object")
+		d.record(name, 1)
+		return
+	}
+
+	var mismatches int64
+	for field, kind := range schema {
+		value, present := decoded[field]
+		if !present {
+			d.log.WithFields(logrus.Fields{"schema": name, "field": field}).Warn("schema drift: field missing from upstream response")
+			mismatches++
+			continue
+		}
+		if !kindMatches(value, kind) {
+			d.log.WithFields(logrus.Fields{"schema": name, "field": field}).Warn("schema drift: field kind does not match expected schema")
+			mismatches++
+		}
+	}
+	if mismatches > 0 {
+		d.record(name, mismatches)
+	}
+}
+
+func (d *Detector) record(name string, n int64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.counts[name] += n
+}
+
+// Counts returns a snapshot of cumulative mismatch counts per schema name,
+// for the admin drift-inspection endpoint.
+func (d *Detector) Counts() map[string]int64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	out := make(map[string]int64, len(d.counts))
+	for name, n := range d.counts {
+		out[name] = n
+	}
+	return out
+}
+
+func kindMatches(value interface{}, kind FieldKind) bool {
+	switch kind {
+	case KindString:
+		_, ok := value.(string)
+		return ok
+	case KindNumber:
+		_, ok := value.(float64)
+		return ok
+	case KindBool:
+		_, ok := value.(bool)
+		return ok
+	case KindObject:
+		_, ok := value.(map[string]interface{})
+		return ok
+	case KindArray:
+		_, ok := value.([]interface{})
+		return ok
+	default:
+		return false
+	}
+}
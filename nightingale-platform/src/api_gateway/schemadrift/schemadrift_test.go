@@ -0,0 +1,46 @@
+package schemadrift
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestCheckCountsMissingAndMismatchedFields(t *testing.T) {
+	log := logrus.New()
+	log.SetOutput(testWriter{t})
+
+	d := NewDetector(log, map[string]Schema{
+		"patient": {
+			"id":        KindNumber,
+			"last_name": KindString,
+			"active":    KindBool,
+			"allergies": KindArray,
+		},
+	})
+
+	d.Check("patient", []byte(`{"id": "not-a-number", "last_name": "Lovelace", "active": true}`))
+
+	counts := d.Counts()
+	if counts["patient"] != 2 {
+		t.Fatalf("counts = %+v, want 2 (id kind mismatch + missing allergies)", counts)
+	}
+}
+
+func TestCheckIgnoresUnknownSchemaName(t *testing.T) {
+	log := logrus.New()
+	log.SetOutput(testWriter{t})
+
+	d := NewDetector(log, map[string]Schema{"patient": {"id": KindNumber}})
+	d.Check("unregistered", []byte(`{}`))
+
+	if len(d.Counts()) != 0 {
+		t.Fatalf("counts = %+v, want empty", d.Counts())
+	}
+}
+
+type testWriter struct{ t *testing.T }
+
+func (w testWriter) Write(p []byte) (int, error) {
+	return len(p), nil
+}
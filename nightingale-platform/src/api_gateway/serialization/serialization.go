@@ -0,0 +1,122 @@
+// Package serialization converts the backend's JSON patient responses into
+// the format a caller asked for via its Accept header, so clients that
+// speak XML or FHIR don't need their own adapter in front of the gateway.
+package serialization
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+)
+
+// Format identifies a response encoding this package knows how to produce.
+type Format string
+
+const (
+	FormatJSON Format = "application/json"
+	FormatXML  Format = "application/xml"
+	FormatFHIR Format = "application/fhir+json"
+)
+
+// Negotiate picks a Format from an HTTP Accept header value, honoring the
+// client's listed preference order. Quality values (;q=) are ignored since
+// callers only ever list one or two types in practice; unrecognized or
+// absent Accept headers fall back to JSON, the backend's native shape.
+func Negotiate(accept string) Format {
+	for _, candidate := range strings.Split(accept, ",") {
+		mediaType := strings.TrimSpace(strings.SplitN(candidate, ";", 2)[0])
+		switch mediaType {
+		case string(FormatXML):
+			return FormatXML
+		case string(FormatFHIR):
+			return FormatFHIR
+		case string(FormatJSON), "*/*":
+			return FormatJSON
+		}
+	}
+	return FormatJSON
+}
+
+// patient is the subset of the backend's patient JSON this package
+// understands; it mirrors handlers.PatientSchema.
+type patient struct {
+	ID          json.Number `json:"id"`
+	MRN         string      `json:"mrn"`
+	FirstName   string      `json:"first_name"`
+	LastName    string      `json:"last_name"`
+	DateOfBirth string      `json:"date_of_birth"`
+}
+
+// patientXML is patient's XML wire shape; encoding/json and encoding/xml
+// can't share struct tags for fields that need different casing.
+type patientXML struct {
+	XMLName     xml.Name `xml:"patient"`
+	ID          string   `xml:"id"`
+	MRN         string   `xml:"mrn"`
+	FirstName   string   `xml:"first_name"`
+	LastName    string   `xml:"last_name"`
+	DateOfBirth string   `xml:"date_of_birth"`
+}
+
+// fhirPatient is a minimal FHIR R4 Patient resource covering the fields the
+// backend exposes. It is not a complete FHIR implementation.
+type fhirPatient struct {
+	ResourceType string           `json:"resourceType"`
+	ID           string           `json:"id"`
+	Identifier   []fhirIdentifier `json:"identifier,omitempty"`
+	Name         []fhirHumanName  `json:"name,omitempty"`
+	BirthDate    string           `json:"birthDate,omitempty"`
+}
+
+type fhirIdentifier struct {
+	System string `json:"system,omitempty"`
+	Value  string `json:"value"`
+}
+
+type fhirHumanName struct {
+	Family string   `json:"family,omitempty"`
+	Given  []string `json:"given,omitempty"`
+}
+
+// EncodePatient re-encodes a backend patient JSON body as format, returning
+// the converted bytes and the Content-Type header to send with them. When
+// format is FormatJSON, body is returned unchanged.
+func EncodePatient(body []byte, format Format) ([]byte, string, error) {
+	if format == FormatJSON {
+		return body, string(FormatJSON), nil
+	}
+
+	var p patient
+	if err := json.Unmarshal(body, &p); err != nil {
+		return nil, "", err
+	}
+
+	switch format {
+	case FormatXML:
+		out, err := xml.Marshal(patientXML{
+			ID:          p.ID.String(),
+			MRN:         p.MRN,
+			FirstName:   p.FirstName,
+			LastName:    p.LastName,
+			DateOfBirth: p.DateOfBirth,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return append([]byte(xml.Header), out...), string(FormatXML), nil
+	case FormatFHIR:
+		out, err := json.Marshal(fhirPatient{
+			ResourceType: "Patient",
+			ID:           p.ID.String(),
+			Identifier:   []fhirIdentifier{{System: "urn:nightingale:mrn", Value: p.MRN}},
+			Name:         []fhirHumanName{{Family: p.LastName, Given: []string{p.FirstName}}},
+			BirthDate:    p.DateOfBirth,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return out, string(FormatFHIR), nil
+	default:
+		return body, string(FormatJSON), nil
+	}
+}
@@ -0,0 +1,71 @@
+package serialization
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNegotiate(t *testing.T) {
+	cases := []struct {
+		accept string
+		want   Format
+	}{
+		{"", FormatJSON},
+		{"application/json", FormatJSON},
+		{"application/xml", FormatXML},
+		{"application/fhir+json", FormatFHIR},
+		{"application/fhir+json;q=0.9, application/xml;q=0.1", FormatFHIR},
+		{"text/plain, application/xml", FormatXML},
+		{"*/*", FormatJSON},
+	}
+	for _, tc := range cases {
+		if got := Negotiate(tc.accept); got != tc.want {
+			t.Errorf("Negotiate(%q) = %q, want %q", tc.accept, got, tc.want)
+		}
+	}
+}
+
+func TestEncodePatientXML(t *testing.T) {
+	body := []byte(`{"id":7,"mrn":"MRN-7","first_name":"Jane","last_name":"Doe","date_of_birth":"1990-01-02"}`)
+	out, contentType, err := EncodePatient(body, FormatXML)
+	if err != nil {
+		t.Fatalf("EncodePatient: %v", err)
+	}
+	if contentType != string(FormatXML) {
+		t.Fatalf("contentType = %q, want %q", contentType, FormatXML)
+	}
+	if !strings.Contains(string(out), "<mrn>MRN-7</mrn>") {
+		t.Fatalf("out = %s, want an <mrn> element", out)
+	}
+}
+
+func TestEncodePatientFHIR(t *testing.T) {
+	body := []byte(`{"id":7,"mrn":"MRN-7","first_name":"Jane","last_name":"Doe","date_of_birth":"1990-01-02"}`)
+	out, contentType, err := EncodePatient(body, FormatFHIR)
+	if err != nil {
+		t.Fatalf("EncodePatient: %v", err)
+	}
+	if contentType != string(FormatFHIR) {
+		t.Fatalf("contentType = %q, want %q", contentType, FormatFHIR)
+	}
+	if !strings.Contains(string(out), `"resourceType":"Patient"`) {
+		t.Fatalf("out = %s, want a resourceType of Patient", out)
+	}
+	if !strings.Contains(string(out), `"birthDate":"1990-01-02"`) {
+		t.Fatalf("out = %s, want birthDate 1990-01-02", out)
+	}
+}
+
+func TestEncodePatientJSONPassesThrough(t *testing.T) {
+	body := []byte(`{"id":7,"mrn":"MRN-7"}`)
+	out, contentType, err := EncodePatient(body, FormatJSON)
+	if err != nil {
+		t.Fatalf("EncodePatient: %v", err)
+	}
+	if contentType != string(FormatJSON) {
+		t.Fatalf("contentType = %q, want %q", contentType, FormatJSON)
+	}
+	if string(out) != string(body) {
+		t.Fatalf("out = %s, want unchanged body %s", out, body)
+	}
+}
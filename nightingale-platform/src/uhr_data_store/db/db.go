@@ -0,0 +1,55 @@
+// Package db opens and migrates the backend's application database.
+//
+// UHR-SCHEMA-1.0's bulk clinical timeline data lives in Cloud Spanner (see
+// the query service), but backend CRUD entities such as providers,
+// facilities, and care-team tasks are served from a conventional relational
+// store via GORM.
+package db
+
+import (
+	"fmt"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/models"
+)
+
+// Open connects to Postgres at dsn and runs auto-migration for every model
+// this package knows about.
+func Open(dsn string) (*gorm.DB, error) {
+	database, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+	if err := AutoMigrate(database); err != nil {
+		return nil, err
+	}
+	return database, nil
+}
+
+// AutoMigrate applies pending schema changes for every model in this
+// service. Exported so tests can run it against an in-memory database.
+func AutoMigrate(database *gorm.DB) error {
+	return database.AutoMigrate(
+		&models.Provider{},
+		&models.ScheduleTemplate{},
+		&models.Patient{},
+		&models.Appointment{},
+		&models.Encounter{},
+		&models.ClinicalNote{},
+		&models.Prescription{},
+		&models.LabOrder{},
+		&models.LabResult{},
+		&models.VitalSign{},
+		&models.Allergy{},
+		&models.Referral{},
+		&models.Document{},
+		&models.AuditLog{},
+		&models.Consent{},
+		&models.Task{},
+		&models.Organization{},
+		&models.Facility{},
+		&models.Department{},
+	)
+}
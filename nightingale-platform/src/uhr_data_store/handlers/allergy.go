@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/models"
+)
+
+// AllergyHandler serves the backend's coded allergy-list routes.
+type AllergyHandler struct {
+	db *gorm.DB
+}
+
+// NewAllergyHandler constructs an AllergyHandler backed by db.
+func NewAllergyHandler(db *gorm.DB) *AllergyHandler {
+	return &AllergyHandler{db: db}
+}
+
+type allergyQuery struct {
+	ID             uint   `json:"id"`
+	PatientID      uint   `json:"patient_id"`
+	Substance      string `json:"substance"`
+	CodeSystem     string `json:"code_system"`
+	Code           string `json:"code"`
+	Reaction       string `json:"reaction"`
+	Severity       string `json:"severity"`
+	NoLongerActive bool   `json:"no_longer_active"`
+}
+
+// List returns a patient's allergy list. Resolved/retracted entries are
+// included by default since a care team reviewing the chart needs to see
+// what was ruled out, not just what's currently active; pass active_only=true
+// to suppress them.
+func (h *AllergyHandler) List(c *gin.Context) {
+	patientID := c.Query("patient_id")
+	if patientID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "patient_id is required"})
+		return
+	}
+
+	query := h.db.Model(&models.Allergy{}).Where("patient_id = ?", patientID)
+	if c.Query("active_only") == "true" {
+		query = query.Where("no_longer_active = ?", false)
+	}
+
+	var allergies []models.Allergy
+	if err := query.Order("severity DESC, substance").Find(&allergies).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list allergies"})
+		return
+	}
+
+	out := make([]allergyQuery, 0, len(allergies))
+	for _, a := range allergies {
+		out = append(out, toAllergyQuery(a))
+	}
+	c.JSON(http.StatusOK, gin.H{"allergies": out})
+}
+
+type createAllergyRequest struct {
+	PatientID  uint   `json:"patient_id" binding:"required"`
+	Substance  string `json:"substance" binding:"required"`
+	CodeSystem string `json:"code_system" binding:"required"`
+	Code       string `json:"code" binding:"required"`
+	Reaction   string `json:"reaction"`
+	Severity   string `json:"severity" binding:"required"`
+}
+
+// Create adds a coded allergy to a patient's chart.
+func (h *AllergyHandler) Create(c *gin.Context) {
+	var req createAllergyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	allergy := models.Allergy{
+		PatientID:  req.PatientID,
+		Substance:  req.Substance,
+		CodeSystem: req.CodeSystem,
+		Code:       req.Code,
+		Reaction:   req.Reaction,
+		Severity:   models.AllergySeverity(req.Severity),
+	}
+	if err := h.db.Create(&allergy).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create allergy"})
+		return
+	}
+	c.JSON(http.StatusCreated, toAllergyQuery(allergy))
+}
+
+// Resolve marks an allergy no longer active, e.g. after it's been
+// clinically ruled out, without deleting the record from the chart.
+func (h *AllergyHandler) Resolve(c *gin.Context) {
+	var allergy models.Allergy
+	if err := h.db.First(&allergy, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "allergy not found"})
+		return
+	}
+	if err := h.db.Model(&allergy).Update("no_longer_active", true).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to resolve allergy"})
+		return
+	}
+	allergy.NoLongerActive = true
+	c.JSON(http.StatusOK, toAllergyQuery(allergy))
+}
+
+func toAllergyQuery(a models.Allergy) allergyQuery {
+	return allergyQuery{
+		ID:             a.ID,
+		PatientID:      a.PatientID,
+		Substance:      a.Substance,
+		CodeSystem:     a.CodeSystem,
+		Code:           a.Code,
+		Reaction:       a.Reaction,
+		Severity:       string(a.Severity),
+		NoLongerActive: a.NoLongerActive,
+	}
+}
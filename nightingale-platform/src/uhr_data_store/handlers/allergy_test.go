@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/db"
+)
+
+func newTestAllergyRouter(t *testing.T) (*gin.Engine, *gorm.DB) {
+	t.Helper()
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open in-memory database: %v", err)
+	}
+	if err := db.AutoMigrate(database); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	h := NewAllergyHandler(database)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/allergies", h.List)
+	r.POST("/allergies", h.Create)
+	r.POST("/allergies/:id/resolve", h.Resolve)
+	return r, database
+}
+
+func TestAllergyHandler_ResolveExcludesFromActiveOnlyList(t *testing.T) {
+	r, _ := newTestAllergyRouter(t)
+
+	body, _ := json.Marshal(createAllergyRequest{
+		PatientID: 1, Substance: "Penicillin", CodeSystem: "RxNorm", Code: "7980", Severity: "severe",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/allergies", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create status = %d, want 201: %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/allergies/1/resolve", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("resolve status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/allergies?patient_id=1&active_only=true", nil))
+	var resp struct {
+		Allergies []allergyQuery `json:"allergies"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if len(resp.Allergies) != 0 {
+		t.Fatalf("active_only list = %+v, want empty", resp.Allergies)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/allergies?patient_id=1", nil))
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if len(resp.Allergies) != 1 || !resp.Allergies[0].NoLongerActive {
+		t.Fatalf("full list = %+v, want one resolved allergy", resp.Allergies)
+	}
+}
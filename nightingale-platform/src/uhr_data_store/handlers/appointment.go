@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/models"
+	"github.com/nightingale-health/uhr-data-store/queryparams"
+)
+
+// AppointmentHandler serves the backend's appointment-scheduling routes.
+// Create checks the requested slot against the provider's
+// ScheduleTemplate and against existing bookings before inserting, so the
+// gateway doesn't need to duplicate availability logic.
+type AppointmentHandler struct {
+	db *gorm.DB
+}
+
+// NewAppointmentHandler constructs an AppointmentHandler backed by db.
+func NewAppointmentHandler(db *gorm.DB) *AppointmentHandler {
+	return &AppointmentHandler{db: db}
+}
+
+type appointmentQuery struct {
+	ID         uint      `json:"id"`
+	PatientID  uint      `json:"patient_id"`
+	ProviderID uint      `json:"provider_id"`
+	StartTime  time.Time `json:"start_time"`
+	EndTime    time.Time `json:"end_time"`
+	Status     string    `json:"status"`
+	Reason     string    `json:"reason"`
+}
+
+// appointmentSortColumns whitelists the columns callers may sort
+// appointments by, so a "sort" query param can never reach raw SQL.
+var appointmentSortColumns = queryparams.SortWhitelist{
+	"start_time": "start_time",
+	"status":     "status",
+	"created_at": "created_at",
+}
+
+const defaultAppointmentSort = "start_time"
+
+// List returns appointments, optionally filtered by patient or provider,
+// paginated and sorted.
+func (h *AppointmentHandler) List(c *gin.Context) {
+	page, perPage := queryparams.Pagination(c)
+
+	query := h.db.Model(&models.Appointment{})
+	if patientID, ok := queryparams.StringFilter(c, "patient_id"); ok {
+		query = query.Where("patient_id = ?", patientID)
+	}
+	if providerID, ok := queryparams.StringFilter(c, "provider_id"); ok {
+		query = query.Where("provider_id = ?", providerID)
+	}
+	if status, ok := queryparams.StringFilter(c, "status"); ok {
+		query = query.Where("status = ?", status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count appointments"})
+		return
+	}
+
+	sortClause := appointmentSortColumns.Clause(c, "sort", defaultAppointmentSort)
+	var appointments []models.Appointment
+	if err := query.Order(sortClause).Offset((page - 1) * perPage).Limit(perPage).Find(&appointments).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list appointments"})
+		return
+	}
+
+	out := make([]appointmentQuery, 0, len(appointments))
+	for _, a := range appointments {
+		out = append(out, toAppointmentQuery(a))
+	}
+	c.JSON(http.StatusOK, gin.H{"appointments": out, "total": total, "page": page, "per_page": perPage})
+}
+
+// Get returns a single appointment by ID.
+func (h *AppointmentHandler) Get(c *gin.Context) {
+	var appointment models.Appointment
+	if err := h.db.First(&appointment, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "appointment not found"})
+		return
+	}
+	c.JSON(http.StatusOK, toAppointmentQuery(appointment))
+}
+
+type createAppointmentRequest struct {
+	PatientID  uint      `json:"patient_id" binding:"required"`
+	ProviderID uint      `json:"provider_id" binding:"required"`
+	StartTime  time.Time `json:"start_time" binding:"required"`
+	EndTime    time.Time `json:"end_time" binding:"required"`
+	Reason     string    `json:"reason"`
+}
+
+// Create books an appointment after checking the slot falls within the
+// provider's recurring weekly availability and doesn't overlap an existing
+// still-scheduled booking for that provider (cancelled, completed, and
+// no-show bookings are not counted).
+func (h *AppointmentHandler) Create(c *gin.Context) {
+	var req createAppointmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !req.EndTime.After(req.StartTime) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "end_time must be after start_time"})
+		return
+	}
+
+	withinTemplate, err := h.withinScheduleTemplate(req.ProviderID, req.StartTime, req.EndTime)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check provider availability"})
+		return
+	}
+	if !withinTemplate {
+		c.JSON(http.StatusConflict, gin.H{"error": "requested slot is outside the provider's availability"})
+		return
+	}
+
+	var overlapping int64
+	err = h.db.Model(&models.Appointment{}).
+		Where("provider_id = ? AND status = ? AND start_time < ? AND end_time > ?",
+			req.ProviderID, models.AppointmentScheduled, req.EndTime, req.StartTime).
+		Count(&overlapping).Error
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to check for conflicts"})
+		return
+	}
+	if overlapping > 0 {
+		c.JSON(http.StatusConflict, gin.H{"error": "requested slot overlaps an existing appointment"})
+		return
+	}
+
+	appointment := models.Appointment{
+		PatientID:  req.PatientID,
+		ProviderID: req.ProviderID,
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
+		Status:     models.AppointmentScheduled,
+		Reason:     req.Reason,
+	}
+	if err := h.db.Create(&appointment).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create appointment"})
+		return
+	}
+	c.JSON(http.StatusCreated, toAppointmentQuery(appointment))
+}
+
+// withinScheduleTemplate reports whether [start, end) falls entirely within
+// one of the provider's recurring weekly availability windows. A provider
+// with no templates is treated as unbounded/unconfigured, so new providers
+// can be booked before their template is entered.
+func (h *AppointmentHandler) withinScheduleTemplate(providerID uint, start, end time.Time) (bool, error) {
+	var templates []models.ScheduleTemplate
+	if err := h.db.Where("provider_id = ?", providerID).Find(&templates).Error; err != nil {
+		return false, err
+	}
+	if len(templates) == 0 {
+		return true, nil
+	}
+	startHHMM := start.Format("15:04")
+	endHHMM := end.Format("15:04")
+	for _, tpl := range templates {
+		if tpl.Weekday == start.Weekday() && startHHMM >= tpl.StartTime && endHHMM <= tpl.EndTime {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// UpdateStatus transitions an appointment's status (e.g. to cancelled,
+// completed, or no_show).
+type updateAppointmentStatusRequest struct {
+	Status models.AppointmentStatus `json:"status" binding:"required"`
+}
+
+func (h *AppointmentHandler) UpdateStatus(c *gin.Context) {
+	var appointment models.Appointment
+	if err := h.db.First(&appointment, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "appointment not found"})
+		return
+	}
+
+	var req updateAppointmentStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	appointment.Status = req.Status
+	if err := h.db.Save(&appointment).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update appointment"})
+		return
+	}
+	c.JSON(http.StatusOK, toAppointmentQuery(appointment))
+}
+
+func toAppointmentQuery(a models.Appointment) appointmentQuery {
+	return appointmentQuery{
+		ID:         a.ID,
+		PatientID:  a.PatientID,
+		ProviderID: a.ProviderID,
+		StartTime:  a.StartTime,
+		EndTime:    a.EndTime,
+		Status:     string(a.Status),
+		Reason:     a.Reason,
+	}
+}
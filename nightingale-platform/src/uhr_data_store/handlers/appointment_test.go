@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/db"
+	"github.com/nightingale-health/uhr-data-store/models"
+)
+
+func newTestAppointmentRouter(t *testing.T) (*gin.Engine, *gorm.DB) {
+	t.Helper()
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open in-memory database: %v", err)
+	}
+	if err := db.AutoMigrate(database); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	h := NewAppointmentHandler(database)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/appointments", h.List)
+	r.GET("/appointments/:id", h.Get)
+	r.POST("/appointments", h.Create)
+	r.PUT("/appointments/:id/status", h.UpdateStatus)
+	return r, database
+}
+
+// nextWeekday returns the next date on or after base that falls on
+// weekday, so schedule-template tests don't depend on what day it is run.
+func nextWeekday(base time.Time, weekday time.Weekday) time.Time {
+	for base.Weekday() != weekday {
+		base = base.AddDate(0, 0, 1)
+	}
+	return base
+}
+
+func TestAppointmentHandler_CreateWithoutTemplateIsUnbounded(t *testing.T) {
+	r, _ := newTestAppointmentRouter(t)
+
+	start := time.Now().Add(24 * time.Hour)
+	body, _ := json.Marshal(createAppointmentRequest{
+		PatientID: 1, ProviderID: 1, StartTime: start, EndTime: start.Add(30 * time.Minute),
+	})
+	req := httptest.NewRequest(http.MethodPost, "/appointments", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create status = %d, want 201: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestAppointmentHandler_CreateOutsideTemplateConflicts(t *testing.T) {
+	r, database := newTestAppointmentRouter(t)
+	database.Create(&models.ScheduleTemplate{ProviderID: 1, Weekday: time.Monday, StartTime: "09:00", EndTime: "12:00"})
+
+	day := nextWeekday(time.Now(), time.Monday)
+	start := time.Date(day.Year(), day.Month(), day.Day(), 14, 0, 0, 0, time.UTC)
+	body, _ := json.Marshal(createAppointmentRequest{
+		PatientID: 1, ProviderID: 1, StartTime: start, EndTime: start.Add(30 * time.Minute),
+	})
+	req := httptest.NewRequest(http.MethodPost, "/appointments", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusConflict {
+		t.Fatalf("create status = %d, want 409 (outside 09:00-12:00 template): %s", w.Code, w.Body.String())
+	}
+}
+
+func TestAppointmentHandler_CreateOverlappingExistingBookingConflicts(t *testing.T) {
+	r, database := newTestAppointmentRouter(t)
+	database.Create(&models.ScheduleTemplate{ProviderID: 1, Weekday: time.Monday, StartTime: "09:00", EndTime: "12:00"})
+
+	day := nextWeekday(time.Now(), time.Monday)
+	start := time.Date(day.Year(), day.Month(), day.Day(), 9, 0, 0, 0, time.UTC)
+	database.Create(&models.Appointment{
+		PatientID: 2, ProviderID: 1, StartTime: start, EndTime: start.Add(time.Hour), Status: models.AppointmentScheduled,
+	})
+
+	body, _ := json.Marshal(createAppointmentRequest{
+		PatientID: 1, ProviderID: 1, StartTime: start.Add(30 * time.Minute), EndTime: start.Add(90 * time.Minute),
+	})
+	req := httptest.NewRequest(http.MethodPost, "/appointments", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusConflict {
+		t.Fatalf("create status = %d, want 409 (overlaps existing booking): %s", w.Code, w.Body.String())
+	}
+}
+
+func TestAppointmentHandler_UpdateStatusCancels(t *testing.T) {
+	r, database := newTestAppointmentRouter(t)
+	start := time.Now().Add(time.Hour)
+	database.Create(&models.Appointment{PatientID: 1, ProviderID: 1, StartTime: start, EndTime: start.Add(time.Hour), Status: models.AppointmentScheduled})
+
+	body, _ := json.Marshal(updateAppointmentStatusRequest{Status: models.AppointmentCancelled})
+	req := httptest.NewRequest(http.MethodPut, "/appointments/1/status", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+
+	var updated appointmentQuery
+	json.Unmarshal(w.Body.Bytes(), &updated)
+	if updated.Status != string(models.AppointmentCancelled) {
+		t.Fatalf("status = %q, want cancelled", updated.Status)
+	}
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/models"
+)
+
+// AuditHandler exposes the change history GORM hooks record on audited
+// tables (patients, clinical_notes).
+type AuditHandler struct {
+	db *gorm.DB
+}
+
+// NewAuditHandler constructs an AuditHandler backed by db.
+func NewAuditHandler(db *gorm.DB) *AuditHandler {
+	return &AuditHandler{db: db}
+}
+
+type auditLogQuery struct {
+	ID        uint   `json:"id"`
+	TableName string `json:"table_name"`
+	RecordID  uint   `json:"record_id"`
+	Action    string `json:"action"`
+	Before    string `json:"before,omitempty"`
+	After     string `json:"after,omitempty"`
+}
+
+// History returns every audit row recorded for one entity, oldest first,
+// so a reviewer can replay exactly how it changed over time.
+func (h *AuditHandler) History(c *gin.Context) {
+	var logs []models.AuditLog
+	if err := h.db.Where("table_name = ? AND record_id = ?", c.Param("table"), c.Param("id")).
+		Order("created_at ASC").Find(&logs).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to load audit history"})
+		return
+	}
+
+	out := make([]auditLogQuery, 0, len(logs))
+	for _, l := range logs {
+		out = append(out, auditLogQuery{
+			ID:        l.ID,
+			TableName: l.TableName,
+			RecordID:  l.RecordID,
+			Action:    string(l.Action),
+			Before:    l.Before,
+			After:     l.After,
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{"audit_logs": out})
+}
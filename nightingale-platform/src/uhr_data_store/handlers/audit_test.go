@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/db"
+	"github.com/nightingale-health/uhr-data-store/models"
+)
+
+func newTestAuditRouter(t *testing.T) (*gin.Engine, *gorm.DB) {
+	t.Helper()
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open in-memory database: %v", err)
+	}
+	if err := db.AutoMigrate(database); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	h := NewAuditHandler(database)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/audit/:table/:id/history", h.History)
+	return r, database
+}
+
+func TestAuditHandler_RecordsCreateAndUpdateForPatients(t *testing.T) {
+	r, database := newTestAuditRouter(t)
+
+	patient := models.Patient{MRN: "MRN-100", FirstName: "Nina", LastName: "Okafor", DateOfBirth: time.Now()}
+	if err := database.Create(&patient).Error; err != nil {
+		t.Fatalf("create patient: %v", err)
+	}
+	if err := database.Model(&patient).Update("last_name", "Okafor-Brooks").Error; err != nil {
+		t.Fatalf("update patient: %v", err)
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/audit/patients/"+strconv.FormatUint(uint64(patient.ID), 10)+"/history", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("history status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+
+	var resp struct {
+		AuditLogs []auditLogQuery `json:"audit_logs"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if len(resp.AuditLogs) != 2 {
+		t.Fatalf("audit logs = %+v, want 2 (create + update)", resp.AuditLogs)
+	}
+	if resp.AuditLogs[0].Action != "create" || resp.AuditLogs[1].Action != "update" {
+		t.Fatalf("audit log actions = %q, %q, want create then update", resp.AuditLogs[0].Action, resp.AuditLogs[1].Action)
+	}
+}
@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/models"
+	"github.com/nightingale-health/uhr-data-store/queryparams"
+)
+
+// ClinicalNoteHandler serves the backend's versioned clinical-note routes.
+// Every write inserts a new version row rather than mutating an existing
+// one; see models.ClinicalNote.
+type ClinicalNoteHandler struct {
+	db *gorm.DB
+}
+
+// NewClinicalNoteHandler constructs a ClinicalNoteHandler backed by db.
+func NewClinicalNoteHandler(db *gorm.DB) *ClinicalNoteHandler {
+	return &ClinicalNoteHandler{db: db}
+}
+
+type clinicalNoteQuery struct {
+	ID          uint      `json:"id"`
+	NoteID      uint      `json:"note_id"`
+	Version     int       `json:"version"`
+	PatientID   uint      `json:"patient_id"`
+	EncounterID *uint     `json:"encounter_id,omitempty"`
+	AuthorID    uint      `json:"author_id"`
+	Body        string    `json:"body"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// clinicalNoteSortColumns whitelists the columns callers may sort notes
+// by, so a "sort" query param can never reach raw SQL.
+var clinicalNoteSortColumns = queryparams.SortWhitelist{
+	"created_at": "created_at",
+	"version":    "version",
+}
+
+const defaultClinicalNoteSort = "created_at DESC"
+
+// List returns the current version of every note for a patient, paginated
+// and sorted; earlier versions are only reachable through History.
+func (h *ClinicalNoteHandler) List(c *gin.Context) {
+	page, perPage := queryparams.Pagination(c)
+
+	query := h.db.Model(&models.ClinicalNote{}).
+		Where("(note_id, version) IN (SELECT note_id, MAX(version) FROM clinical_notes GROUP BY note_id)")
+	if patientID, ok := queryparams.StringFilter(c, "patient_id"); ok {
+		query = query.Where("patient_id = ?", patientID)
+	}
+	if authorID, ok := queryparams.StringFilter(c, "author_id"); ok {
+		query = query.Where("author_id = ?", authorID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count clinical notes"})
+		return
+	}
+
+	sortClause := clinicalNoteSortColumns.Clause(c, "sort", defaultClinicalNoteSort)
+	var notes []models.ClinicalNote
+	if err := query.Order(sortClause).Offset((page - 1) * perPage).Limit(perPage).Find(&notes).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list clinical notes"})
+		return
+	}
+
+	out := make([]clinicalNoteQuery, 0, len(notes))
+	for _, n := range notes {
+		out = append(out, toClinicalNoteQuery(n))
+	}
+	c.JSON(http.StatusOK, gin.H{"clinical_notes": out, "total": total, "page": page, "per_page": perPage})
+}
+
+// Get returns the current (highest-version) row for note_id.
+func (h *ClinicalNoteHandler) Get(c *gin.Context) {
+	note, err := h.currentVersion(c.Param("note_id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "clinical note not found"})
+		return
+	}
+	c.JSON(http.StatusOK, toClinicalNoteQuery(note))
+}
+
+// History returns every version of note_id, oldest first.
+func (h *ClinicalNoteHandler) History(c *gin.Context) {
+	var versions []models.ClinicalNote
+	if err := h.db.Where("note_id = ?", c.Param("note_id")).Order("version").Find(&versions).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to load note history"})
+		return
+	}
+	if len(versions) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "clinical note not found"})
+		return
+	}
+	out := make([]clinicalNoteQuery, 0, len(versions))
+	for _, v := range versions {
+		out = append(out, toClinicalNoteQuery(v))
+	}
+	c.JSON(http.StatusOK, gin.H{"versions": out})
+}
+
+type createClinicalNoteRequest struct {
+	PatientID   uint   `json:"patient_id" binding:"required"`
+	EncounterID *uint  `json:"encounter_id"`
+	AuthorID    uint   `json:"author_id" binding:"required"`
+	Body        string `json:"body" binding:"required"`
+}
+
+// Create starts a brand-new note at version 1.
+func (h *ClinicalNoteHandler) Create(c *gin.Context) {
+	var req createClinicalNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	note := models.ClinicalNote{
+		PatientID:   req.PatientID,
+		EncounterID: req.EncounterID,
+		AuthorID:    req.AuthorID,
+		Body:        req.Body,
+		Version:     1,
+	}
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&note).Error; err != nil {
+			return err
+		}
+		// A fresh note's NoteID is its own row ID, there being no earlier
+		// version to group under.
+		return tx.Model(&note).Update("note_id", note.ID).Error
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create clinical note"})
+		return
+	}
+	note.NoteID = note.ID
+	c.JSON(http.StatusCreated, toClinicalNoteQuery(note))
+}
+
+type updateClinicalNoteRequest struct {
+	AuthorID uint   `json:"author_id" binding:"required"`
+	Body     string `json:"body" binding:"required"`
+}
+
+// Update inserts a new version of note_id with the given body, leaving
+// every earlier version untouched.
+func (h *ClinicalNoteHandler) Update(c *gin.Context) {
+	current, err := h.currentVersion(c.Param("note_id"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "clinical note not found"})
+		return
+	}
+
+	var req updateClinicalNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	next := models.ClinicalNote{
+		NoteID:      current.NoteID,
+		Version:     current.Version + 1,
+		PatientID:   current.PatientID,
+		EncounterID: current.EncounterID,
+		AuthorID:    req.AuthorID,
+		Body:        req.Body,
+	}
+	if err := h.db.Create(&next).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create new note version"})
+		return
+	}
+	c.JSON(http.StatusOK, toClinicalNoteQuery(next))
+}
+
+func (h *ClinicalNoteHandler) currentVersion(noteID string) (models.ClinicalNote, error) {
+	var note models.ClinicalNote
+	err := h.db.Where("note_id = ?", noteID).Order("version DESC").First(&note).Error
+	return note, err
+}
+
+func toClinicalNoteQuery(n models.ClinicalNote) clinicalNoteQuery {
+	return clinicalNoteQuery{
+		ID:          n.ID,
+		NoteID:      n.NoteID,
+		Version:     n.Version,
+		PatientID:   n.PatientID,
+		EncounterID: n.EncounterID,
+		AuthorID:    n.AuthorID,
+		Body:        n.Body,
+		CreatedAt:   n.CreatedAt,
+	}
+}
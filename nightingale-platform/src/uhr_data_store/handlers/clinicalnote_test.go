@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/db"
+)
+
+func newTestClinicalNoteRouter(t *testing.T) (*gin.Engine, *gorm.DB) {
+	t.Helper()
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open in-memory database: %v", err)
+	}
+	if err := db.AutoMigrate(database); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	h := NewClinicalNoteHandler(database)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/clinical-notes", h.List)
+	r.GET("/clinical-notes/:note_id", h.Get)
+	r.GET("/clinical-notes/:note_id/history", h.History)
+	r.POST("/clinical-notes", h.Create)
+	r.PUT("/clinical-notes/:note_id", h.Update)
+	return r, database
+}
+
+func TestClinicalNoteHandler_UpdateCreatesNewVersionAndPreservesHistory(t *testing.T) {
+	r, _ := newTestClinicalNoteRouter(t)
+
+	body, _ := json.Marshal(createClinicalNoteRequest{PatientID: 1, AuthorID: 1, Body: "v1 body"})
+	req := httptest.NewRequest(http.MethodPost, "/clinical-notes", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create status = %d, want 201: %s", w.Code, w.Body.String())
+	}
+	var created clinicalNoteQuery
+	json.Unmarshal(w.Body.Bytes(), &created)
+	if created.Version != 1 {
+		t.Fatalf("created version = %d, want 1", created.Version)
+	}
+
+	body, _ = json.Marshal(updateClinicalNoteRequest{AuthorID: 1, Body: "v2 body"})
+	req = httptest.NewRequest(http.MethodPut, "/clinical-notes/"+strconv.FormatUint(uint64(created.NoteID), 10), bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("update status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/clinical-notes/"+strconv.FormatUint(uint64(created.NoteID), 10), nil))
+	var current clinicalNoteQuery
+	json.Unmarshal(w.Body.Bytes(), &current)
+	if current.Version != 2 || current.Body != "v2 body" {
+		t.Fatalf("current = %+v, want version 2 with v2 body", current)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/clinical-notes/"+strconv.FormatUint(uint64(created.NoteID), 10)+"/history", nil))
+	var history struct {
+		Versions []clinicalNoteQuery `json:"versions"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &history)
+	if len(history.Versions) != 2 {
+		t.Fatalf("history length = %d, want 2 (original version must be preserved)", len(history.Versions))
+	}
+	if history.Versions[0].Body != "v1 body" {
+		t.Fatalf("history[0].Body = %q, want v1 body", history.Versions[0].Body)
+	}
+}
+
+func TestClinicalNoteHandler_ListReturnsOnlyCurrentVersionPerNote(t *testing.T) {
+	r, _ := newTestClinicalNoteRouter(t)
+
+	body, _ := json.Marshal(createClinicalNoteRequest{PatientID: 5, AuthorID: 1, Body: "v1"})
+	req := httptest.NewRequest(http.MethodPost, "/clinical-notes", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	var created clinicalNoteQuery
+	json.Unmarshal(w.Body.Bytes(), &created)
+
+	body, _ = json.Marshal(updateClinicalNoteRequest{AuthorID: 1, Body: "v2"})
+	req = httptest.NewRequest(http.MethodPut, "/clinical-notes/"+strconv.FormatUint(uint64(created.NoteID), 10), bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("update status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+
+	body, _ = json.Marshal(createClinicalNoteRequest{PatientID: 6, AuthorID: 1, Body: "other patient's note"})
+	req = httptest.NewRequest(http.MethodPost, "/clinical-notes", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/clinical-notes?patient_id=5", nil))
+	var listed struct {
+		ClinicalNotes []clinicalNoteQuery `json:"clinical_notes"`
+		Total         int64               `json:"total"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &listed)
+	if listed.Total != 1 || len(listed.ClinicalNotes) != 1 {
+		t.Fatalf("listed = %+v, want exactly 1 note scoped to patient 5", listed)
+	}
+	if listed.ClinicalNotes[0].Version != 2 || listed.ClinicalNotes[0].Body != "v2" {
+		t.Fatalf("listed note = %+v, want current version 2", listed.ClinicalNotes[0])
+	}
+}
@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/models"
+)
+
+// ConsentHandler serves the backend's consent capture/revocation routes,
+// consumed by the gateway's consent-enforcement layer.
+type ConsentHandler struct {
+	db *gorm.DB
+}
+
+// NewConsentHandler constructs a ConsentHandler backed by db.
+func NewConsentHandler(db *gorm.DB) *ConsentHandler {
+	return &ConsentHandler{db: db}
+}
+
+type consentQuery struct {
+	ID              uint       `json:"id"`
+	PatientID       uint       `json:"patient_id"`
+	Scope           string     `json:"scope"`
+	Grantee         string     `json:"grantee"`
+	EffectiveAt     time.Time  `json:"effective_at"`
+	ExpiresAt       *time.Time `json:"expires_at,omitempty"`
+	SignatureName   string     `json:"signature_name"`
+	SignatureMethod string     `json:"signature_method"`
+	RevokedAt       *time.Time `json:"revoked_at,omitempty"`
+	Active          bool       `json:"active"`
+}
+
+// List returns a patient's consent records. Pass active_only=true to
+// check only those the gateway's enforcement layer would currently honor.
+func (h *ConsentHandler) List(c *gin.Context) {
+	patientID := c.Query("patient_id")
+	if patientID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "patient_id is required"})
+		return
+	}
+
+	var consents []models.Consent
+	if err := h.db.Where("patient_id = ?", patientID).Order("effective_at DESC").Find(&consents).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list consents"})
+		return
+	}
+
+	now := time.Now()
+	out := make([]consentQuery, 0, len(consents))
+	for _, con := range consents {
+		if c.Query("active_only") == "true" && !con.IsActive(now) {
+			continue
+		}
+		out = append(out, toConsentQuery(con, now))
+	}
+	c.JSON(http.StatusOK, gin.H{"consents": out})
+}
+
+type createConsentRequest struct {
+	PatientID       uint       `json:"patient_id" binding:"required"`
+	Scope           string     `json:"scope" binding:"required"`
+	Grantee         string     `json:"grantee" binding:"required"`
+	EffectiveAt     time.Time  `json:"effective_at" binding:"required"`
+	ExpiresAt       *time.Time `json:"expires_at"`
+	SignatureName   string     `json:"signature_name" binding:"required"`
+	SignatureMethod string     `json:"signature_method" binding:"required"`
+}
+
+// Create captures a new consent record.
+func (h *ConsentHandler) Create(c *gin.Context) {
+	var req createConsentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	consent := models.Consent{
+		PatientID:       req.PatientID,
+		Scope:           models.ConsentScope(req.Scope),
+		Grantee:         req.Grantee,
+		EffectiveAt:     req.EffectiveAt,
+		ExpiresAt:       req.ExpiresAt,
+		SignatureName:   req.SignatureName,
+		SignatureMethod: req.SignatureMethod,
+	}
+	if err := h.db.Create(&consent).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create consent"})
+		return
+	}
+	c.JSON(http.StatusCreated, toConsentQuery(consent, time.Now()))
+}
+
+// Revoke withdraws a consent. It is not deleted; RevokedAt is set so the
+// record of having once consented remains part of the compliance trail.
+func (h *ConsentHandler) Revoke(c *gin.Context) {
+	var consent models.Consent
+	if err := h.db.First(&consent, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "consent not found"})
+		return
+	}
+	if consent.RevokedAt != nil {
+		c.JSON(http.StatusConflict, gin.H{"error": "consent already revoked"})
+		return
+	}
+
+	now := time.Now()
+	if err := h.db.Model(&consent).Update("revoked_at", now).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to revoke consent"})
+		return
+	}
+	consent.RevokedAt = &now
+	c.JSON(http.StatusOK, toConsentQuery(consent, now))
+}
+
+func toConsentQuery(c models.Consent, at time.Time) consentQuery {
+	return consentQuery{
+		ID:              c.ID,
+		PatientID:       c.PatientID,
+		Scope:           string(c.Scope),
+		Grantee:         c.Grantee,
+		EffectiveAt:     c.EffectiveAt,
+		ExpiresAt:       c.ExpiresAt,
+		SignatureName:   c.SignatureName,
+		SignatureMethod: c.SignatureMethod,
+		RevokedAt:       c.RevokedAt,
+		Active:          c.IsActive(at),
+	}
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/db"
+	"github.com/nightingale-health/uhr-data-store/models"
+)
+
+func newTestConsentRouter(t *testing.T) (*gin.Engine, *gorm.DB) {
+	t.Helper()
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open in-memory database: %v", err)
+	}
+	if err := db.AutoMigrate(database); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	h := NewConsentHandler(database)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/consents", h.List)
+	r.POST("/consents", h.Create)
+	r.POST("/consents/:id/revoke", h.Revoke)
+	return r, database
+}
+
+func TestConsentHandler_RevokeExcludesFromActiveOnlyList(t *testing.T) {
+	r, _ := newTestConsentRouter(t)
+
+	body, _ := json.Marshal(createConsentRequest{
+		PatientID: 1, Scope: "full_record", Grantee: "Dr. Lee",
+		EffectiveAt: time.Now().Add(-time.Hour), SignatureName: "Jane Doe", SignatureMethod: "electronic",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/consents", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create status = %d, want 201: %s", w.Code, w.Body.String())
+	}
+	var created consentQuery
+	json.Unmarshal(w.Body.Bytes(), &created)
+	if !created.Active {
+		t.Fatalf("created consent active = %v, want true", created.Active)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/consents/1/revoke", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("revoke status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/consents?patient_id=1&active_only=true", nil))
+	var resp struct {
+		Consents []consentQuery `json:"consents"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if len(resp.Consents) != 0 {
+		t.Fatalf("active_only list = %+v, want empty after revoke", resp.Consents)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/consents/1/revoke", nil))
+	if w.Code != http.StatusConflict {
+		t.Fatalf("double revoke status = %d, want 409", w.Code)
+	}
+}
+
+func TestConsentHandler_ExpiredConsentIsExcludedFromActiveOnlyList(t *testing.T) {
+	r, database := newTestConsentRouter(t)
+	expiresAt := time.Now().Add(-time.Minute)
+	database.Create(&models.Consent{
+		PatientID: 2, Scope: models.ConsentScopeBilling, Grantee: "Acme Insurance",
+		EffectiveAt: time.Now().Add(-2 * time.Hour), ExpiresAt: &expiresAt,
+		SignatureName: "John Roe", SignatureMethod: "electronic",
+	})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/consents?patient_id=2&active_only=true", nil))
+	var resp struct {
+		Consents []consentQuery `json:"consents"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if len(resp.Consents) != 0 {
+		t.Fatalf("active_only list = %+v, want empty for expired consent", resp.Consents)
+	}
+}
@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/models"
+	"github.com/nightingale-health/uhr-data-store/objectstore"
+)
+
+// allowedDocumentContentTypes is the upload allowlist; anything else is
+// rejected before it ever reaches the object store.
+var allowedDocumentContentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/png":       true,
+	"image/jpeg":      true,
+}
+
+// Scanner checks uploaded bytes for malware before they're persisted.
+// NoopScanner is the default for local development; production should
+// wire in a real engine (e.g. ClamAV over its ICAP/TCP protocol).
+type Scanner interface {
+	Scan(data []byte) (clean bool, err error)
+}
+
+// NoopScanner treats every upload as clean. It exists so the handler has
+// a usable default without pulling in a scanning dependency by default.
+type NoopScanner struct{}
+
+// Scan always reports clean.
+func (NoopScanner) Scan(data []byte) (bool, error) { return true, nil }
+
+// DocumentHandler serves the backend's document upload/download routes.
+type DocumentHandler struct {
+	db      *gorm.DB
+	store   objectstore.Store
+	scanner Scanner
+}
+
+// NewDocumentHandler constructs a DocumentHandler backed by db, storing
+// blobs in store and scanning uploads with scanner.
+func NewDocumentHandler(db *gorm.DB, store objectstore.Store, scanner Scanner) *DocumentHandler {
+	return &DocumentHandler{db: db, store: store, scanner: scanner}
+}
+
+type documentQuery struct {
+	ID          uint   `json:"id"`
+	PatientID   uint   `json:"patient_id"`
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	SizeBytes   int64  `json:"size_bytes"`
+	Category    string `json:"category"`
+	ScanStatus  string `json:"scan_status"`
+}
+
+// List returns a patient's document metadata, optionally filtered by
+// category.
+func (h *DocumentHandler) List(c *gin.Context) {
+	patientID := c.Query("patient_id")
+	if patientID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "patient_id is required"})
+		return
+	}
+
+	query := h.db.Model(&models.Document{}).Where("patient_id = ?", patientID)
+	if category := c.Query("category"); category != "" {
+		query = query.Where("category = ?", category)
+	}
+
+	var documents []models.Document
+	if err := query.Order("created_at DESC").Find(&documents).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list documents"})
+		return
+	}
+
+	out := make([]documentQuery, 0, len(documents))
+	for _, d := range documents {
+		out = append(out, toDocumentQuery(d))
+	}
+	c.JSON(http.StatusOK, gin.H{"documents": out})
+}
+
+// Upload accepts a multipart file upload, scans it, and stores it in the
+// object store if clean. A rejected scan never reaches the store.
+func (h *DocumentHandler) Upload(c *gin.Context) {
+	patientID, err := strconv.ParseUint(c.PostForm("patient_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "patient_id is required"})
+		return
+	}
+	uploadedByID, err := strconv.ParseUint(c.PostForm("uploaded_by_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "uploaded_by_id is required"})
+		return
+	}
+	category := c.PostForm("category")
+	if category == "" {
+		category = string(models.DocumentOther)
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+		return
+	}
+	contentType := fileHeader.Header.Get("Content-Type")
+	if !allowedDocumentContentTypes[contentType] {
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": "content type not allowed"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read upload"})
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read upload"})
+		return
+	}
+
+	clean, err := h.scanner.Scan(data)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "virus scan failed"})
+		return
+	}
+	if !clean {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": "upload failed virus scan"})
+		return
+	}
+
+	storageKey := fmt.Sprintf("patients/%d/documents/%d-%s", patientID, time.Now().UnixNano(), fileHeader.Filename)
+	if err := h.store.Put(c.Request.Context(), storageKey, bytes.NewReader(data)); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to store document"})
+		return
+	}
+
+	document := models.Document{
+		PatientID:    uint(patientID),
+		UploadedByID: uint(uploadedByID),
+		FileName:     fileHeader.Filename,
+		ContentType:  contentType,
+		SizeBytes:    int64(len(data)),
+		Category:     models.DocumentCategory(category),
+		StorageKey:   storageKey,
+		ScanStatus:   models.DocumentScanClean,
+	}
+	if err := h.db.Create(&document).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to record document"})
+		return
+	}
+	c.JSON(http.StatusCreated, toDocumentQuery(document))
+}
+
+// Download streams a document's bytes back to the caller.
+func (h *DocumentHandler) Download(c *gin.Context) {
+	var document models.Document
+	if err := h.db.First(&document, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "document not found"})
+		return
+	}
+
+	blob, err := h.store.Get(c.Request.Context(), document.StorageKey)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read document"})
+		return
+	}
+	defer blob.Close()
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", document.FileName))
+	c.DataFromReader(http.StatusOK, document.SizeBytes, document.ContentType, blob, nil)
+}
+
+// Delete removes a document's metadata and its backing blob.
+func (h *DocumentHandler) Delete(c *gin.Context) {
+	var document models.Document
+	if err := h.db.First(&document, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "document not found"})
+		return
+	}
+	if err := h.store.Delete(c.Request.Context(), document.StorageKey); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete stored document"})
+		return
+	}
+	if err := h.db.Delete(&document).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete document record"})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func toDocumentQuery(d models.Document) documentQuery {
+	return documentQuery{
+		ID:          d.ID,
+		PatientID:   d.PatientID,
+		FileName:    d.FileName,
+		ContentType: d.ContentType,
+		SizeBytes:   d.SizeBytes,
+		Category:    string(d.Category),
+		ScanStatus:  string(d.ScanStatus),
+	}
+}
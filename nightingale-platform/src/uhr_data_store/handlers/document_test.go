@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/db"
+	"github.com/nightingale-health/uhr-data-store/objectstore"
+)
+
+type rejectingScanner struct{}
+
+func (rejectingScanner) Scan(data []byte) (bool, error) { return false, nil }
+
+func newTestDocumentRouter(t *testing.T, scanner Scanner) (*gin.Engine, *gorm.DB) {
+	t.Helper()
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open in-memory database: %v", err)
+	}
+	if err := db.AutoMigrate(database); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	store, err := objectstore.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("create local store: %v", err)
+	}
+
+	h := NewDocumentHandler(database, store, scanner)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/documents", h.List)
+	r.POST("/documents", h.Upload)
+	r.GET("/documents/:id/download", h.Download)
+	r.DELETE("/documents/:id", h.Delete)
+	return r, database
+}
+
+func multipartUpload(t *testing.T, fileName, contentType string, content []byte) (*bytes.Buffer, string) {
+	t.Helper()
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	w.WriteField("patient_id", "1")
+	w.WriteField("uploaded_by_id", "2")
+	w.WriteField("category", "consent_form")
+
+	partHeader := make(map[string][]string)
+	partHeader["Content-Disposition"] = []string{`form-data; name="file"; filename="` + fileName + `"`}
+	partHeader["Content-Type"] = []string{contentType}
+	part, err := w.CreatePart(partHeader)
+	if err != nil {
+		t.Fatalf("create part: %v", err)
+	}
+	part.Write(content)
+	w.Close()
+	return &buf, w.FormDataContentType()
+}
+
+func TestDocumentHandler_UploadThenDownloadRoundTrips(t *testing.T) {
+	r, _ := newTestDocumentRouter(t, NoopScanner{})
+
+	body, contentType := multipartUpload(t, "consent.pdf", "application/pdf", []byte("%PDF-1.4 fake"))
+	req := httptest.NewRequest(http.MethodPost, "/documents", body)
+	req.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("upload status = %d, want 201: %s", w.Code, w.Body.String())
+	}
+	var created documentQuery
+	json.Unmarshal(w.Body.Bytes(), &created)
+	if created.ScanStatus != "clean" {
+		t.Fatalf("scan status = %q, want clean", created.ScanStatus)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/documents/1/download", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("download status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+	if w.Body.String() != "%PDF-1.4 fake" {
+		t.Fatalf("downloaded body = %q, want original content", w.Body.String())
+	}
+}
+
+func TestDocumentHandler_UploadRejectsInfectedFile(t *testing.T) {
+	r, _ := newTestDocumentRouter(t, rejectingScanner{})
+
+	body, contentType := multipartUpload(t, "evil.pdf", "application/pdf", []byte("eicar"))
+	req := httptest.NewRequest(http.MethodPost, "/documents", body)
+	req.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("upload status = %d, want 422: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestDocumentHandler_UploadRejectsDisallowedContentType(t *testing.T) {
+	r, _ := newTestDocumentRouter(t, NoopScanner{})
+
+	body, contentType := multipartUpload(t, "script.exe", "application/x-msdownload", []byte("MZ"))
+	req := httptest.NewRequest(http.MethodPost, "/documents", body)
+	req.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusUnsupportedMediaType {
+		t.Fatalf("upload status = %d, want 415: %s", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/models"
+	"github.com/nightingale-health/uhr-data-store/queryparams"
+)
+
+// EncounterHandler serves the backend's visit-record routes.
+type EncounterHandler struct {
+	db *gorm.DB
+}
+
+// NewEncounterHandler constructs an EncounterHandler backed by db.
+func NewEncounterHandler(db *gorm.DB) *EncounterHandler {
+	return &EncounterHandler{db: db}
+}
+
+type encounterQuery struct {
+	ID             uint       `json:"id"`
+	PatientID      uint       `json:"patient_id"`
+	ProviderID     uint       `json:"provider_id"`
+	AppointmentID  *uint      `json:"appointment_id,omitempty"`
+	Type           string     `json:"type"`
+	StartedAt      time.Time  `json:"started_at"`
+	EndedAt        *time.Time `json:"ended_at,omitempty"`
+	ChiefComplaint string     `json:"chief_complaint"`
+}
+
+// List returns a patient's encounters, most recent first, paginated.
+func (h *EncounterHandler) List(c *gin.Context) {
+	page, perPage := queryparams.Pagination(c)
+
+	query := h.db.Model(&models.Encounter{})
+	if patientID := c.Query("patient_id"); patientID != "" {
+		query = query.Where("patient_id = ?", patientID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count encounters"})
+		return
+	}
+
+	var encounters []models.Encounter
+	if err := query.Order("started_at DESC").Offset((page - 1) * perPage).Limit(perPage).Find(&encounters).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list encounters"})
+		return
+	}
+
+	out := make([]encounterQuery, 0, len(encounters))
+	for _, e := range encounters {
+		out = append(out, toEncounterQuery(e))
+	}
+	c.JSON(http.StatusOK, gin.H{"encounters": out, "total": total, "page": page, "per_page": perPage})
+}
+
+// Get returns a single encounter by ID.
+func (h *EncounterHandler) Get(c *gin.Context) {
+	var encounter models.Encounter
+	if err := h.db.First(&encounter, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "encounter not found"})
+		return
+	}
+	c.JSON(http.StatusOK, toEncounterQuery(encounter))
+}
+
+type createEncounterRequest struct {
+	PatientID      uint                 `json:"patient_id" binding:"required"`
+	ProviderID     uint                 `json:"provider_id" binding:"required"`
+	AppointmentID  *uint                `json:"appointment_id"`
+	Type           models.EncounterType `json:"type" binding:"required"`
+	StartedAt      time.Time            `json:"started_at" binding:"required"`
+	ChiefComplaint string               `json:"chief_complaint"`
+}
+
+// Create opens a new encounter.
+func (h *EncounterHandler) Create(c *gin.Context) {
+	var req createEncounterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	encounter := models.Encounter{
+		PatientID:      req.PatientID,
+		ProviderID:     req.ProviderID,
+		AppointmentID:  req.AppointmentID,
+		Type:           req.Type,
+		StartedAt:      req.StartedAt,
+		ChiefComplaint: req.ChiefComplaint,
+	}
+	if err := h.db.Create(&encounter).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create encounter"})
+		return
+	}
+	c.JSON(http.StatusCreated, toEncounterQuery(encounter))
+}
+
+// Close sets EndedAt to now, marking the visit as finished.
+func (h *EncounterHandler) Close(c *gin.Context) {
+	var encounter models.Encounter
+	if err := h.db.First(&encounter, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "encounter not found"})
+		return
+	}
+	if encounter.EndedAt != nil {
+		c.JSON(http.StatusConflict, gin.H{"error": "encounter is already closed"})
+		return
+	}
+	now := time.Now()
+	encounter.EndedAt = &now
+	if err := h.db.Save(&encounter).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to close encounter"})
+		return
+	}
+	c.JSON(http.StatusOK, toEncounterQuery(encounter))
+}
+
+func toEncounterQuery(e models.Encounter) encounterQuery {
+	return encounterQuery{
+		ID:             e.ID,
+		PatientID:      e.PatientID,
+		ProviderID:     e.ProviderID,
+		AppointmentID:  e.AppointmentID,
+		Type:           string(e.Type),
+		StartedAt:      e.StartedAt,
+		EndedAt:        e.EndedAt,
+		ChiefComplaint: e.ChiefComplaint,
+	}
+}
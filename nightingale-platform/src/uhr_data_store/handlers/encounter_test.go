@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/db"
+	"github.com/nightingale-health/uhr-data-store/models"
+)
+
+func newTestEncounterRouter(t *testing.T) (*gin.Engine, *gorm.DB) {
+	t.Helper()
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open in-memory database: %v", err)
+	}
+	if err := db.AutoMigrate(database); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	h := NewEncounterHandler(database)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/encounters", h.List)
+	r.GET("/encounters/:id", h.Get)
+	r.POST("/encounters", h.Create)
+	r.POST("/encounters/:id/close", h.Close)
+	return r, database
+}
+
+func TestEncounterHandler_CreateThenClose(t *testing.T) {
+	r, _ := newTestEncounterRouter(t)
+
+	body, _ := json.Marshal(createEncounterRequest{
+		PatientID: 1, ProviderID: 1, Type: models.EncounterOutpatient, StartedAt: time.Now(), ChiefComplaint: "cough",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/encounters", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create status = %d, want 201: %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/encounters/1/close", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("close status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+	var closed encounterQuery
+	json.Unmarshal(w.Body.Bytes(), &closed)
+	if closed.EndedAt == nil {
+		t.Fatal("expected EndedAt to be set after Close")
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/encounters/1/close", nil))
+	if w.Code != http.StatusConflict {
+		t.Fatalf("double-close status = %d, want 409", w.Code)
+	}
+}
+
+func TestEncounterHandler_ListFiltersByPatient(t *testing.T) {
+	r, database := newTestEncounterRouter(t)
+	database.Create(&models.Encounter{PatientID: 1, ProviderID: 1, Type: models.EncounterOutpatient, StartedAt: time.Now()})
+	database.Create(&models.Encounter{PatientID: 2, ProviderID: 1, Type: models.EncounterOutpatient, StartedAt: time.Now()})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/encounters?patient_id=1", nil))
+	var resp struct {
+		Total int64 `json:"total"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if resp.Total != 1 {
+		t.Fatalf("total = %d, want 1", resp.Total)
+	}
+}
@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/models"
+	"github.com/nightingale-health/uhr-data-store/queryparams"
+)
+
+// LabHandler serves the backend's lab-order and lab-result routes.
+type LabHandler struct {
+	db *gorm.DB
+}
+
+// NewLabHandler constructs a LabHandler backed by db.
+func NewLabHandler(db *gorm.DB) *LabHandler {
+	return &LabHandler{db: db}
+}
+
+type labResultQuery struct {
+	ID            uint     `json:"id"`
+	Component     string   `json:"component"`
+	Value         string   `json:"value"`
+	Unit          string   `json:"unit"`
+	ReferenceLow  *float64 `json:"reference_low,omitempty"`
+	ReferenceHigh *float64 `json:"reference_high,omitempty"`
+	Abnormal      bool     `json:"abnormal"`
+}
+
+type labOrderQuery struct {
+	ID          uint             `json:"id"`
+	PatientID   uint             `json:"patient_id"`
+	ProviderID  uint             `json:"provider_id"`
+	EncounterID *uint            `json:"encounter_id,omitempty"`
+	TestCode    string           `json:"test_code"`
+	TestName    string           `json:"test_name"`
+	Status      string           `json:"status"`
+	OrderedAt   time.Time        `json:"ordered_at"`
+	Results     []labResultQuery `json:"results,omitempty"`
+}
+
+// labOrderSortColumns whitelists the columns callers may sort lab orders
+// by, so a "sort" query param can never reach raw SQL.
+var labOrderSortColumns = queryparams.SortWhitelist{
+	"ordered_at": "ordered_at",
+	"status":     "status",
+	"test_code":  "test_code",
+}
+
+const defaultLabOrderSort = "ordered_at DESC"
+
+// List returns a patient's lab orders, paginated and sorted.
+func (h *LabHandler) List(c *gin.Context) {
+	page, perPage := queryparams.Pagination(c)
+
+	query := h.db.Model(&models.LabOrder{})
+	if patientID, ok := queryparams.StringFilter(c, "patient_id"); ok {
+		query = query.Where("patient_id = ?", patientID)
+	}
+	if status, ok := queryparams.StringFilter(c, "status"); ok {
+		query = query.Where("status = ?", status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count lab orders"})
+		return
+	}
+
+	sortClause := labOrderSortColumns.Clause(c, "sort", defaultLabOrderSort)
+	var orders []models.LabOrder
+	if err := query.Preload("Results").Order(sortClause).Offset((page - 1) * perPage).Limit(perPage).Find(&orders).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list lab orders"})
+		return
+	}
+
+	out := make([]labOrderQuery, 0, len(orders))
+	for _, o := range orders {
+		out = append(out, toLabOrderQuery(o))
+	}
+	c.JSON(http.StatusOK, gin.H{"lab_orders": out, "total": total, "page": page, "per_page": perPage})
+}
+
+// Get returns a single lab order with its results.
+func (h *LabHandler) Get(c *gin.Context) {
+	var order models.LabOrder
+	if err := h.db.Preload("Results").First(&order, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "lab order not found"})
+		return
+	}
+	c.JSON(http.StatusOK, toLabOrderQuery(order))
+}
+
+type createLabOrderRequest struct {
+	PatientID   uint      `json:"patient_id" binding:"required"`
+	ProviderID  uint      `json:"provider_id" binding:"required"`
+	EncounterID *uint     `json:"encounter_id"`
+	TestCode    string    `json:"test_code" binding:"required"`
+	TestName    string    `json:"test_name" binding:"required"`
+	OrderedAt   time.Time `json:"ordered_at" binding:"required"`
+}
+
+// Create places a new lab order.
+func (h *LabHandler) Create(c *gin.Context) {
+	var req createLabOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	order := models.LabOrder{
+		PatientID:   req.PatientID,
+		ProviderID:  req.ProviderID,
+		EncounterID: req.EncounterID,
+		TestCode:    req.TestCode,
+		TestName:    req.TestName,
+		Status:      models.LabOrderPending,
+		OrderedAt:   req.OrderedAt,
+	}
+	if err := h.db.Create(&order).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create lab order"})
+		return
+	}
+	c.JSON(http.StatusCreated, toLabOrderQuery(order))
+}
+
+type addLabResultRequest struct {
+	Component     string   `json:"component" binding:"required"`
+	Value         string   `json:"value" binding:"required"`
+	Unit          string   `json:"unit"`
+	ReferenceLow  *float64 `json:"reference_low"`
+	ReferenceHigh *float64 `json:"reference_high"`
+	Abnormal      bool     `json:"abnormal"`
+}
+
+// AddResult attaches a result to an order and, once at least one result
+// exists, marks the order complete.
+func (h *LabHandler) AddResult(c *gin.Context) {
+	var order models.LabOrder
+	if err := h.db.First(&order, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "lab order not found"})
+		return
+	}
+
+	var req addLabResultRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result := models.LabResult{
+		LabOrderID:    order.ID,
+		Component:     req.Component,
+		Value:         req.Value,
+		Unit:          req.Unit,
+		ReferenceLow:  req.ReferenceLow,
+		ReferenceHigh: req.ReferenceHigh,
+		Abnormal:      req.Abnormal,
+	}
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&result).Error; err != nil {
+			return err
+		}
+		return tx.Model(&order).Update("status", models.LabOrderComplete).Error
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to add lab result"})
+		return
+	}
+	c.JSON(http.StatusCreated, toLabResultQuery(result))
+}
+
+func toLabOrderQuery(o models.LabOrder) labOrderQuery {
+	results := make([]labResultQuery, 0, len(o.Results))
+	for _, r := range o.Results {
+		results = append(results, toLabResultQuery(r))
+	}
+	return labOrderQuery{
+		ID:          o.ID,
+		PatientID:   o.PatientID,
+		ProviderID:  o.ProviderID,
+		EncounterID: o.EncounterID,
+		TestCode:    o.TestCode,
+		TestName:    o.TestName,
+		Status:      string(o.Status),
+		OrderedAt:   o.OrderedAt,
+		Results:     results,
+	}
+}
+
+func toLabResultQuery(r models.LabResult) labResultQuery {
+	return labResultQuery{
+		ID:            r.ID,
+		Component:     r.Component,
+		Value:         r.Value,
+		Unit:          r.Unit,
+		ReferenceLow:  r.ReferenceLow,
+		ReferenceHigh: r.ReferenceHigh,
+		Abnormal:      r.Abnormal,
+	}
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/db"
+	"github.com/nightingale-health/uhr-data-store/models"
+)
+
+func newTestLabRouter(t *testing.T) (*gin.Engine, *gorm.DB) {
+	t.Helper()
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open in-memory database: %v", err)
+	}
+	if err := db.AutoMigrate(database); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	h := NewLabHandler(database)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/lab-orders", h.List)
+	r.GET("/lab-orders/:id", h.Get)
+	r.POST("/lab-orders", h.Create)
+	r.POST("/lab-orders/:id/results", h.AddResult)
+	return r, database
+}
+
+func TestLabHandler_AddResultMarksOrderComplete(t *testing.T) {
+	r, _ := newTestLabRouter(t)
+
+	body, _ := json.Marshal(createLabOrderRequest{
+		PatientID: 1, ProviderID: 1, TestCode: "58410-2", TestName: "CBC panel", OrderedAt: time.Now(),
+	})
+	req := httptest.NewRequest(http.MethodPost, "/lab-orders", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create order status = %d, want 201: %s", w.Code, w.Body.String())
+	}
+
+	body, _ = json.Marshal(addLabResultRequest{Component: "Hemoglobin", Value: "13.5", Unit: "g/dL"})
+	req = httptest.NewRequest(http.MethodPost, "/lab-orders/1/results", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("add result status = %d, want 201: %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/lab-orders/1", nil))
+	var order labOrderQuery
+	json.Unmarshal(w.Body.Bytes(), &order)
+	if order.Status != string(models.LabOrderComplete) {
+		t.Fatalf("order status = %q, want complete", order.Status)
+	}
+	if len(order.Results) != 1 || order.Results[0].Component != "Hemoglobin" {
+		t.Fatalf("order results = %+v, want one Hemoglobin result", order.Results)
+	}
+}
@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/models"
+)
+
+// OrganizationHandler serves the backend's org-structure routes:
+// organizations, the facilities under them, and the departments under
+// those. Patient and Provider queries scope against FacilityID; this
+// handler is what that ID resolves to. Appointments carry no FacilityID.
+type OrganizationHandler struct {
+	db *gorm.DB
+}
+
+// NewOrganizationHandler constructs an OrganizationHandler backed by db.
+func NewOrganizationHandler(db *gorm.DB) *OrganizationHandler {
+	return &OrganizationHandler{db: db}
+}
+
+type organizationQuery struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+type facilityQuery struct {
+	ID             uint   `json:"id"`
+	OrganizationID uint   `json:"organization_id"`
+	Name           string `json:"name"`
+	AddressLine1   string `json:"address_line1,omitempty"`
+	City           string `json:"city,omitempty"`
+	State          string `json:"state,omitempty"`
+	PostalCode     string `json:"postal_code,omitempty"`
+}
+
+type departmentQuery struct {
+	ID         uint   `json:"id"`
+	FacilityID uint   `json:"facility_id"`
+	Name       string `json:"name"`
+}
+
+// ListOrganizations returns every organization.
+func (h *OrganizationHandler) ListOrganizations(c *gin.Context) {
+	var orgs []models.Organization
+	if err := h.db.Order("name").Find(&orgs).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list organizations"})
+		return
+	}
+	out := make([]organizationQuery, 0, len(orgs))
+	for _, o := range orgs {
+		out = append(out, organizationQuery{ID: o.ID, Name: o.Name})
+	}
+	c.JSON(http.StatusOK, gin.H{"organizations": out})
+}
+
+type createOrganizationRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+// CreateOrganization adds a new organization.
+func (h *OrganizationHandler) CreateOrganization(c *gin.Context) {
+	var req createOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	org := models.Organization{Name: req.Name}
+	if err := h.db.Create(&org).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create organization"})
+		return
+	}
+	c.JSON(http.StatusCreated, organizationQuery{ID: org.ID, Name: org.Name})
+}
+
+// ListFacilities returns facilities, optionally filtered by organization.
+func (h *OrganizationHandler) ListFacilities(c *gin.Context) {
+	query := h.db.Model(&models.Facility{})
+	if orgID := c.Query("organization_id"); orgID != "" {
+		query = query.Where("organization_id = ?", orgID)
+	}
+	var facilities []models.Facility
+	if err := query.Order("name").Find(&facilities).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list facilities"})
+		return
+	}
+	out := make([]facilityQuery, 0, len(facilities))
+	for _, f := range facilities {
+		out = append(out, toFacilityQuery(f))
+	}
+	c.JSON(http.StatusOK, gin.H{"facilities": out})
+}
+
+type createFacilityRequest struct {
+	OrganizationID uint   `json:"organization_id" binding:"required"`
+	Name           string `json:"name" binding:"required"`
+	AddressLine1   string `json:"address_line1"`
+	City           string `json:"city"`
+	State          string `json:"state"`
+	PostalCode     string `json:"postal_code"`
+}
+
+// CreateFacility adds a new facility under an organization.
+func (h *OrganizationHandler) CreateFacility(c *gin.Context) {
+	var req createFacilityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	facility := models.Facility{
+		OrganizationID: req.OrganizationID,
+		Name:           req.Name,
+		AddressLine1:   req.AddressLine1,
+		City:           req.City,
+		State:          req.State,
+		PostalCode:     req.PostalCode,
+	}
+	if err := h.db.Create(&facility).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create facility"})
+		return
+	}
+	c.JSON(http.StatusCreated, toFacilityQuery(facility))
+}
+
+// ListDepartments returns departments, optionally filtered by facility.
+func (h *OrganizationHandler) ListDepartments(c *gin.Context) {
+	query := h.db.Model(&models.Department{})
+	if facilityID := c.Query("facility_id"); facilityID != "" {
+		query = query.Where("facility_id = ?", facilityID)
+	}
+	var departments []models.Department
+	if err := query.Order("name").Find(&departments).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list departments"})
+		return
+	}
+	out := make([]departmentQuery, 0, len(departments))
+	for _, d := range departments {
+		out = append(out, departmentQuery{ID: d.ID, FacilityID: d.FacilityID, Name: d.Name})
+	}
+	c.JSON(http.StatusOK, gin.H{"departments": out})
+}
+
+type createDepartmentRequest struct {
+	FacilityID uint   `json:"facility_id" binding:"required"`
+	Name       string `json:"name" binding:"required"`
+}
+
+// CreateDepartment adds a new department under a facility.
+func (h *OrganizationHandler) CreateDepartment(c *gin.Context) {
+	var req createDepartmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	department := models.Department{FacilityID: req.FacilityID, Name: req.Name}
+	if err := h.db.Create(&department).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create department"})
+		return
+	}
+	c.JSON(http.StatusCreated, departmentQuery{ID: department.ID, FacilityID: department.FacilityID, Name: department.Name})
+}
+
+func toFacilityQuery(f models.Facility) facilityQuery {
+	return facilityQuery{
+		ID:             f.ID,
+		OrganizationID: f.OrganizationID,
+		Name:           f.Name,
+		AddressLine1:   f.AddressLine1,
+		City:           f.City,
+		State:          f.State,
+		PostalCode:     f.PostalCode,
+	}
+}
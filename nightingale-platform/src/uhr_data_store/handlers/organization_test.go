@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/db"
+)
+
+func newTestOrganizationRouter(t *testing.T) (*gin.Engine, *gorm.DB) {
+	t.Helper()
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open in-memory database: %v", err)
+	}
+	if err := db.AutoMigrate(database); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	h := NewOrganizationHandler(database)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/organizations", h.ListOrganizations)
+	r.POST("/organizations", h.CreateOrganization)
+	r.GET("/facilities", h.ListFacilities)
+	r.POST("/facilities", h.CreateFacility)
+	r.GET("/departments", h.ListDepartments)
+	r.POST("/departments", h.CreateDepartment)
+	return r, database
+}
+
+func TestOrganizationHandler_FacilityAndDepartmentScopeToTheirParent(t *testing.T) {
+	r, _ := newTestOrganizationRouter(t)
+
+	body, _ := json.Marshal(createOrganizationRequest{Name: "Nightingale Health System"})
+	req := httptest.NewRequest(http.MethodPost, "/organizations", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create org status = %d, want 201: %s", w.Code, w.Body.String())
+	}
+
+	body, _ = json.Marshal(createFacilityRequest{OrganizationID: 1, Name: "Downtown Clinic"})
+	req = httptest.NewRequest(http.MethodPost, "/facilities", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create facility status = %d, want 201: %s", w.Code, w.Body.String())
+	}
+
+	body, _ = json.Marshal(createDepartmentRequest{FacilityID: 1, Name: "Cardiology"})
+	req = httptest.NewRequest(http.MethodPost, "/departments", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create department status = %d, want 201: %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/facilities?organization_id=1", nil))
+	var facilityResp struct {
+		Facilities []facilityQuery `json:"facilities"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &facilityResp)
+	if len(facilityResp.Facilities) != 1 || facilityResp.Facilities[0].Name != "Downtown Clinic" {
+		t.Fatalf("facilities = %+v, want one Downtown Clinic", facilityResp.Facilities)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/departments?facility_id=1", nil))
+	var departmentResp struct {
+		Departments []departmentQuery `json:"departments"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &departmentResp)
+	if len(departmentResp.Departments) != 1 || departmentResp.Departments[0].Name != "Cardiology" {
+		t.Fatalf("departments = %+v, want one Cardiology", departmentResp.Departments)
+	}
+}
@@ -0,0 +1,279 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/models"
+	"github.com/nightingale-health/uhr-data-store/queryparams"
+)
+
+// PatientHandler serves the backend's patient CRUD routes behind the
+// gateway's proxy. Like ProviderHandler, it does not itself check caller
+// role; authorization is enforced at the gateway.
+type PatientHandler struct {
+	db *gorm.DB
+}
+
+// NewPatientHandler constructs a PatientHandler backed by db.
+func NewPatientHandler(db *gorm.DB) *PatientHandler {
+	return &PatientHandler{db: db}
+}
+
+type patientQuery struct {
+	ID          uint      `json:"id"`
+	MRN         string    `json:"mrn"`
+	FirstName   string    `json:"first_name"`
+	LastName    string    `json:"last_name"`
+	DateOfBirth time.Time `json:"date_of_birth"`
+	Sex         string    `json:"sex"`
+	Email       string    `json:"email"`
+	Phone       string    `json:"phone"`
+	FacilityID  uint      `json:"facility_id"`
+}
+
+// patientSortColumns whitelists the columns callers may sort patients by,
+// so a "sort" query param can never be used to inject arbitrary SQL.
+var patientSortColumns = queryparams.SortWhitelist{
+	"last_name":     "last_name",
+	"first_name":    "first_name",
+	"date_of_birth": "date_of_birth",
+	"created_at":    "created_at",
+}
+
+const defaultPatientSort = "last_name, first_name"
+
+// List returns patients, paginated, sorted, and filtered per the
+// page/per_page/sort/search params the gateway forwards, excluding
+// anything merged away by synth-398's dedup workflow.
+func (h *PatientHandler) List(c *gin.Context) {
+	page, perPage := queryparams.Pagination(c)
+
+	query := h.db.Model(&models.Patient{}).Where("merged_into_id IS NULL")
+	if facilityID := c.Query("facility_id"); facilityID != "" {
+		query = query.Where("facility_id = ?", facilityID)
+	}
+	if mrn := c.Query("mrn"); mrn != "" {
+		query = query.Where("mrn = ?", mrn)
+	}
+	if search := c.Query("search"); search != "" {
+		like := "%" + search + "%"
+		query = query.Where("first_name LIKE ? OR last_name LIKE ? OR mrn LIKE ?", like, like, like)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count patients"})
+		return
+	}
+
+	var patients []models.Patient
+	sortClause := patientSortColumns.Clause(c, "sort", defaultPatientSort)
+	if err := query.Order(sortClause).Offset((page - 1) * perPage).Limit(perPage).Find(&patients).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list patients"})
+		return
+	}
+
+	out := make([]patientQuery, 0, len(patients))
+	for _, p := range patients {
+		out = append(out, toPatientQuery(p))
+	}
+	c.JSON(http.StatusOK, gin.H{"patients": out, "total": total, "page": page, "per_page": perPage})
+}
+
+// Get returns a single patient by ID.
+func (h *PatientHandler) Get(c *gin.Context) {
+	var patient models.Patient
+	if err := h.db.First(&patient, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "patient not found"})
+		return
+	}
+	c.JSON(http.StatusOK, toPatientQuery(patient))
+}
+
+type createPatientRequest struct {
+	MRN         string    `json:"mrn" binding:"required"`
+	FirstName   string    `json:"first_name" binding:"required"`
+	LastName    string    `json:"last_name" binding:"required"`
+	DateOfBirth time.Time `json:"date_of_birth" binding:"required"`
+	Sex         string    `json:"sex"`
+	Email       string    `json:"email"`
+	Phone       string    `json:"phone"`
+	FacilityID  uint      `json:"facility_id"`
+}
+
+// Create registers a new patient.
+func (h *PatientHandler) Create(c *gin.Context) {
+	var req createPatientRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	patient := models.Patient{
+		MRN:         req.MRN,
+		FirstName:   req.FirstName,
+		LastName:    req.LastName,
+		DateOfBirth: req.DateOfBirth,
+		Sex:         req.Sex,
+		Email:       req.Email,
+		Phone:       req.Phone,
+		FacilityID:  req.FacilityID,
+	}
+	if err := h.db.Create(&patient).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create patient"})
+		return
+	}
+	c.JSON(http.StatusCreated, toPatientQuery(patient))
+}
+
+type updatePatientRequest struct {
+	FirstName  *string `json:"first_name"`
+	LastName   *string `json:"last_name"`
+	Sex        *string `json:"sex"`
+	Email      *string `json:"email"`
+	Phone      *string `json:"phone"`
+	FacilityID *uint   `json:"facility_id"`
+}
+
+// Update edits demographic fields on an existing patient. MRN and date of
+// birth are immutable once set, same rationale as Provider's NPI.
+func (h *PatientHandler) Update(c *gin.Context) {
+	var patient models.Patient
+	if err := h.db.First(&patient, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "patient not found"})
+		return
+	}
+
+	var req updatePatientRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.FirstName != nil {
+		patient.FirstName = *req.FirstName
+	}
+	if req.LastName != nil {
+		patient.LastName = *req.LastName
+	}
+	if req.Sex != nil {
+		patient.Sex = *req.Sex
+	}
+	if req.Email != nil {
+		patient.Email = *req.Email
+	}
+	if req.Phone != nil {
+		patient.Phone = *req.Phone
+	}
+	if req.FacilityID != nil {
+		patient.FacilityID = *req.FacilityID
+	}
+
+	if err := h.db.Save(&patient).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update patient"})
+		return
+	}
+	c.JSON(http.StatusOK, toPatientQuery(patient))
+}
+
+// Delete soft-deletes a patient; see Restore for undoing this.
+func (h *PatientHandler) Delete(c *gin.Context) {
+	// Load the row first so the AfterDelete audit hook sees its ID.
+	var patient models.Patient
+	if err := h.db.First(&patient, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "patient not found"})
+		return
+	}
+	if err := h.db.Delete(&patient).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete patient"})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// Restore undoes a soft delete, so a record removed in error (or during a
+// patient-initiated deletion that's reversed within a grace period) comes
+// back without losing its ID or history.
+func (h *PatientHandler) Restore(c *gin.Context) {
+	var patient models.Patient
+	if err := h.db.Unscoped().First(&patient, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "patient not found"})
+		return
+	}
+	if !patient.DeletedAt.Valid {
+		c.JSON(http.StatusConflict, gin.H{"error": "patient is not deleted"})
+		return
+	}
+	if err := h.db.Unscoped().Model(&patient).Update("deleted_at", nil).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to restore patient"})
+		return
+	}
+	patient.DeletedAt = gorm.DeletedAt{}
+	c.JSON(http.StatusOK, toPatientQuery(patient))
+}
+
+type mergePatientsRequest struct {
+	DuplicateID uint `json:"duplicate_id" binding:"required"`
+}
+
+// Merge marks the patient identified by duplicate_id as merged into the
+// patient in the :id path param: the duplicate is soft-deleted and its
+// MergedIntoID is set, so neither List nor Get returns it any more; the
+// link to the surviving record stays on the soft-deleted row, where only an
+// unscoped query reaches it. The survivor's
+// own clinical history is left untouched; re-pointing those child records
+// at the survivor is a separate, slower migration, not done inline here.
+func (h *PatientHandler) Merge(c *gin.Context) {
+	var survivor models.Patient
+	if err := h.db.First(&survivor, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "survivor patient not found"})
+		return
+	}
+
+	var req mergePatientsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.DuplicateID == survivor.ID {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "duplicate_id must differ from the survivor"})
+		return
+	}
+
+	var duplicate models.Patient
+	if err := h.db.First(&duplicate, req.DuplicateID).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "duplicate patient not found"})
+		return
+	}
+
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&duplicate).Update("merged_into_id", survivor.ID).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&duplicate).Error
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to merge patients"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"survivor": toPatientQuery(survivor), "merged_id": duplicate.ID})
+}
+
+func toPatientQuery(p models.Patient) patientQuery {
+	return patientQuery{
+		ID:          p.ID,
+		MRN:         p.MRN,
+		FirstName:   p.FirstName,
+		LastName:    p.LastName,
+		DateOfBirth: p.DateOfBirth,
+		Sex:         p.Sex,
+		Email:       p.Email,
+		Phone:       p.Phone,
+		FacilityID:  p.FacilityID,
+	}
+}
@@ -0,0 +1,221 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/db"
+	"github.com/nightingale-health/uhr-data-store/models"
+)
+
+func newTestPatientRouter(t *testing.T) (*gin.Engine, *gorm.DB) {
+	t.Helper()
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open in-memory database: %v", err)
+	}
+	if err := db.AutoMigrate(database); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	h := NewPatientHandler(database)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/patients", h.List)
+	r.GET("/patients/:id", h.Get)
+	r.POST("/patients", h.Create)
+	r.PUT("/patients/:id", h.Update)
+	r.DELETE("/patients/:id", h.Delete)
+	r.POST("/patients/:id/restore", h.Restore)
+	r.POST("/patients/:id/merge", h.Merge)
+	return r, database
+}
+
+func TestPatientHandler_CreateThenGet(t *testing.T) {
+	r, _ := newTestPatientRouter(t)
+
+	body, _ := json.Marshal(createPatientRequest{
+		MRN:         "MRN-001",
+		FirstName:   "Jane",
+		LastName:    "Doe",
+		DateOfBirth: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	req := httptest.NewRequest(http.MethodPost, "/patients", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create status = %d, want 201: %s", w.Code, w.Body.String())
+	}
+
+	var created patientQuery
+	if err := json.Unmarshal(w.Body.Bytes(), &created); err != nil {
+		t.Fatalf("unmarshal create response: %v", err)
+	}
+	if created.ID == 0 {
+		t.Fatal("expected a non-zero patient ID")
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/patients/1", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("get status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+	var fetched patientQuery
+	if err := json.Unmarshal(w.Body.Bytes(), &fetched); err != nil {
+		t.Fatalf("unmarshal get response: %v", err)
+	}
+	if fetched.MRN != "MRN-001" || fetched.LastName != "Doe" {
+		t.Fatalf("fetched patient = %+v, want MRN-001 / Doe", fetched)
+	}
+}
+
+func TestPatientHandler_ListExcludesMergedPatients(t *testing.T) {
+	r, database := newTestPatientRouter(t)
+
+	database.Create(&models.Patient{MRN: "MRN-002", FirstName: "Alice", LastName: "Chen", DateOfBirth: time.Now()})
+	mergedInto := uint(1)
+	database.Create(&models.Patient{MRN: "MRN-003", FirstName: "Bob", LastName: "Alvarez", DateOfBirth: time.Now(), MergedIntoID: &mergedInto})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/patients", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+
+	var resp struct {
+		Patients []patientQuery `json:"patients"`
+		Total    int64          `json:"total"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("unmarshal list response: %v", err)
+	}
+	if resp.Total != 1 {
+		t.Fatalf("total = %d, want 1 (merged patient should be excluded)", resp.Total)
+	}
+}
+
+func TestPatientHandler_DeleteThenGetNotFound(t *testing.T) {
+	r, database := newTestPatientRouter(t)
+	database.Create(&models.Patient{MRN: "MRN-004", FirstName: "Carol", LastName: "Diaz", DateOfBirth: time.Now()})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/patients/1", nil))
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("delete status = %d, want 204: %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/patients/1", nil))
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("get after delete status = %d, want 404", w.Code)
+	}
+}
+
+func TestPatientHandler_RestoreUndoesDelete(t *testing.T) {
+	r, database := newTestPatientRouter(t)
+	database.Create(&models.Patient{MRN: "MRN-005", FirstName: "Dan", LastName: "Evans", DateOfBirth: time.Now()})
+
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodDelete, "/patients/1", nil))
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/patients/1/restore", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("restore status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/patients/1", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("get after restore status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestPatientHandler_RestoreNonDeletedPatientConflicts(t *testing.T) {
+	r, database := newTestPatientRouter(t)
+	database.Create(&models.Patient{MRN: "MRN-006", FirstName: "Eve", LastName: "Foster", DateOfBirth: time.Now()})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/patients/1/restore", nil))
+	if w.Code != http.StatusConflict {
+		t.Fatalf("restore status = %d, want 409: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestPatientHandler_ListSortsAndFiltersBySearchAndMRN(t *testing.T) {
+	r, database := newTestPatientRouter(t)
+	database.Create(&models.Patient{MRN: "MRN-010", FirstName: "Amy", LastName: "Zimmer", DateOfBirth: time.Now()})
+	database.Create(&models.Patient{MRN: "MRN-011", FirstName: "Zoe", LastName: "Adams", DateOfBirth: time.Now()})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/patients?sort=first_name", nil))
+	var resp struct {
+		Patients []patientQuery `json:"patients"`
+		Total    int64          `json:"total"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if len(resp.Patients) != 2 || resp.Patients[0].FirstName != "Amy" {
+		t.Fatalf("sorted patients = %+v, want Amy first", resp.Patients)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/patients?mrn=MRN-011", nil))
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if resp.Total != 1 || resp.Patients[0].FirstName != "Zoe" {
+		t.Fatalf("mrn-filtered patients = %+v, want only Zoe", resp.Patients)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/patients?search=zimmer", nil))
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if resp.Total != 1 || resp.Patients[0].LastName != "Zimmer" {
+		t.Fatalf("search-filtered patients = %+v, want only Zimmer", resp.Patients)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/patients?sort=not_a_real_column", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("unwhitelisted sort status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestPatientHandler_MergeSoftDeletesDuplicateAndHidesItFromList(t *testing.T) {
+	r, database := newTestPatientRouter(t)
+	database.Create(&models.Patient{MRN: "MRN-007", FirstName: "Gail", LastName: "Harper", DateOfBirth: time.Now()})
+	database.Create(&models.Patient{MRN: "MRN-007-DUP", FirstName: "Gail", LastName: "Harper", DateOfBirth: time.Now()})
+
+	body, _ := json.Marshal(mergePatientsRequest{DuplicateID: 2})
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/patients/1/merge", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("merge status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+
+	var dup models.Patient
+	if err := database.Unscoped().First(&dup, 2).Error; err != nil {
+		t.Fatalf("find duplicate: %v", err)
+	}
+	if dup.MergedIntoID == nil || *dup.MergedIntoID != 1 {
+		t.Fatalf("duplicate MergedIntoID = %v, want pointer to 1", dup.MergedIntoID)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/patients", nil))
+	var resp struct {
+		Total int64 `json:"total"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if resp.Total != 1 {
+		t.Fatalf("total = %d, want 1 (merged duplicate should not be listed)", resp.Total)
+	}
+}
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/models"
+	"github.com/nightingale-health/uhr-data-store/queryparams"
+)
+
+// PrescriptionHandler serves the backend's medication-management routes.
+type PrescriptionHandler struct {
+	db *gorm.DB
+}
+
+// NewPrescriptionHandler constructs a PrescriptionHandler backed by db.
+func NewPrescriptionHandler(db *gorm.DB) *PrescriptionHandler {
+	return &PrescriptionHandler{db: db}
+}
+
+type prescriptionQuery struct {
+	ID           uint       `json:"id"`
+	PatientID    uint       `json:"patient_id"`
+	ProviderID   uint       `json:"provider_id"`
+	EncounterID  *uint      `json:"encounter_id,omitempty"`
+	Medication   string     `json:"medication"`
+	Dosage       string     `json:"dosage"`
+	Frequency    string     `json:"frequency"`
+	Status       string     `json:"status"`
+	PrescribedAt time.Time  `json:"prescribed_at"`
+	EndDate      *time.Time `json:"end_date,omitempty"`
+}
+
+// List returns a patient's prescriptions, optionally filtered to active
+// ones only, paginated.
+func (h *PrescriptionHandler) List(c *gin.Context) {
+	page, perPage := queryparams.Pagination(c)
+
+	query := h.db.Model(&models.Prescription{})
+	if patientID := c.Query("patient_id"); patientID != "" {
+		query = query.Where("patient_id = ?", patientID)
+	}
+	if c.Query("active") == "true" {
+		query = query.Where("status = ?", models.PrescriptionActive)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count prescriptions"})
+		return
+	}
+
+	var prescriptions []models.Prescription
+	if err := query.Order("prescribed_at DESC").Offset((page - 1) * perPage).Limit(perPage).Find(&prescriptions).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list prescriptions"})
+		return
+	}
+
+	out := make([]prescriptionQuery, 0, len(prescriptions))
+	for _, p := range prescriptions {
+		out = append(out, toPrescriptionQuery(p))
+	}
+	c.JSON(http.StatusOK, gin.H{"prescriptions": out, "total": total, "page": page, "per_page": perPage})
+}
+
+// Get returns a single prescription by ID.
+func (h *PrescriptionHandler) Get(c *gin.Context) {
+	var prescription models.Prescription
+	if err := h.db.First(&prescription, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "prescription not found"})
+		return
+	}
+	c.JSON(http.StatusOK, toPrescriptionQuery(prescription))
+}
+
+type createPrescriptionRequest struct {
+	PatientID    uint       `json:"patient_id" binding:"required"`
+	ProviderID   uint       `json:"provider_id" binding:"required"`
+	EncounterID  *uint      `json:"encounter_id"`
+	Medication   string     `json:"medication" binding:"required"`
+	Dosage       string     `json:"dosage" binding:"required"`
+	Frequency    string     `json:"frequency" binding:"required"`
+	PrescribedAt time.Time  `json:"prescribed_at" binding:"required"`
+	EndDate      *time.Time `json:"end_date"`
+}
+
+// Create writes a new prescription.
+func (h *PrescriptionHandler) Create(c *gin.Context) {
+	var req createPrescriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	prescription := models.Prescription{
+		PatientID:    req.PatientID,
+		ProviderID:   req.ProviderID,
+		EncounterID:  req.EncounterID,
+		Medication:   req.Medication,
+		Dosage:       req.Dosage,
+		Frequency:    req.Frequency,
+		Status:       models.PrescriptionActive,
+		PrescribedAt: req.PrescribedAt,
+		EndDate:      req.EndDate,
+	}
+	if err := h.db.Create(&prescription).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create prescription"})
+		return
+	}
+	c.JSON(http.StatusCreated, toPrescriptionQuery(prescription))
+}
+
+type updatePrescriptionStatusRequest struct {
+	Status models.PrescriptionStatus `json:"status" binding:"required"`
+}
+
+// UpdateStatus transitions a prescription (e.g. to completed or
+// cancelled).
+func (h *PrescriptionHandler) UpdateStatus(c *gin.Context) {
+	var prescription models.Prescription
+	if err := h.db.First(&prescription, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "prescription not found"})
+		return
+	}
+
+	var req updatePrescriptionStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	prescription.Status = req.Status
+	if err := h.db.Save(&prescription).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update prescription"})
+		return
+	}
+	c.JSON(http.StatusOK, toPrescriptionQuery(prescription))
+}
+
+func toPrescriptionQuery(p models.Prescription) prescriptionQuery {
+	return prescriptionQuery{
+		ID:           p.ID,
+		PatientID:    p.PatientID,
+		ProviderID:   p.ProviderID,
+		EncounterID:  p.EncounterID,
+		Medication:   p.Medication,
+		Dosage:       p.Dosage,
+		Frequency:    p.Frequency,
+		Status:       string(p.Status),
+		PrescribedAt: p.PrescribedAt,
+		EndDate:      p.EndDate,
+	}
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/db"
+	"github.com/nightingale-health/uhr-data-store/models"
+)
+
+func newTestPrescriptionRouter(t *testing.T) (*gin.Engine, *gorm.DB) {
+	t.Helper()
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open in-memory database: %v", err)
+	}
+	if err := db.AutoMigrate(database); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	h := NewPrescriptionHandler(database)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/prescriptions", h.List)
+	r.GET("/prescriptions/:id", h.Get)
+	r.POST("/prescriptions", h.Create)
+	r.PUT("/prescriptions/:id/status", h.UpdateStatus)
+	return r, database
+}
+
+func TestPrescriptionHandler_CreateDefaultsToActive(t *testing.T) {
+	r, _ := newTestPrescriptionRouter(t)
+
+	body, _ := json.Marshal(createPrescriptionRequest{
+		PatientID: 1, ProviderID: 1, Medication: "Amoxicillin", Dosage: "500mg", Frequency: "TID", PrescribedAt: time.Now(),
+	})
+	req := httptest.NewRequest(http.MethodPost, "/prescriptions", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create status = %d, want 201: %s", w.Code, w.Body.String())
+	}
+	var created prescriptionQuery
+	json.Unmarshal(w.Body.Bytes(), &created)
+	if created.Status != string(models.PrescriptionActive) {
+		t.Fatalf("status = %q, want active", created.Status)
+	}
+}
+
+func TestPrescriptionHandler_ListActiveFilter(t *testing.T) {
+	r, database := newTestPrescriptionRouter(t)
+	database.Create(&models.Prescription{PatientID: 1, ProviderID: 1, Medication: "A", Dosage: "1", Frequency: "QD", Status: models.PrescriptionActive, PrescribedAt: time.Now()})
+	database.Create(&models.Prescription{PatientID: 1, ProviderID: 1, Medication: "B", Dosage: "1", Frequency: "QD", Status: models.PrescriptionCompleted, PrescribedAt: time.Now()})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/prescriptions?patient_id=1&active=true", nil))
+	var resp struct {
+		Total int64 `json:"total"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if resp.Total != 1 {
+		t.Fatalf("total = %d, want 1", resp.Total)
+	}
+}
@@ -0,0 +1,194 @@
+// Package handlers implements the backend's HTTP handlers.
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/models"
+	"github.com/nightingale-health/uhr-data-store/queryparams"
+)
+
+// ProviderHandler serves the provider directory: search/list, lookup by ID,
+// and admin-only create/update/delete. Mutation routes are expected to sit
+// behind the gateway's admin auth middleware; this handler does not itself
+// check caller role.
+type ProviderHandler struct {
+	db *gorm.DB
+}
+
+// NewProviderHandler constructs a ProviderHandler backed by db.
+func NewProviderHandler(db *gorm.DB) *ProviderHandler {
+	return &ProviderHandler{db: db}
+}
+
+// providerQuery is the subset of Provider fields returned to callers,
+// including patient users, so schedule-template internals don't leak into
+// every list response.
+type providerQuery struct {
+	ID         uint   `json:"id"`
+	NPI        string `json:"npi"`
+	Name       string `json:"name"`
+	Specialty  string `json:"specialty"`
+	Email      string `json:"email"`
+	Phone      string `json:"phone"`
+	FacilityID uint   `json:"facility_id"`
+}
+
+// List searches the provider directory by name and/or specialty, optionally
+// scoped to a facility, with pagination. Needed by appointment booking and
+// referrals to find a matching provider.
+//
+// Query params: q (name substring), specialty, facility_id, page, per_page.
+func (h *ProviderHandler) List(c *gin.Context) {
+	page, perPage := queryparams.Pagination(c)
+
+	query := h.db.Model(&models.Provider{})
+	if q := c.Query("q"); q != "" {
+		query = query.Where("LOWER(name) LIKE LOWER(?)", "%"+q+"%")
+	}
+	if specialty := c.Query("specialty"); specialty != "" {
+		query = query.Where("specialty = ?", specialty)
+	}
+	if facilityID := c.Query("facility_id"); facilityID != "" {
+		query = query.Where("facility_id = ?", facilityID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count providers"})
+		return
+	}
+
+	var providers []models.Provider
+	if err := query.Order("name").Offset((page - 1) * perPage).Limit(perPage).Find(&providers).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list providers"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"providers": toProviderQueries(providers),
+		"total":     total,
+		"page":      page,
+		"per_page":  perPage,
+	})
+}
+
+// Get returns a single provider by ID.
+func (h *ProviderHandler) Get(c *gin.Context) {
+	var provider models.Provider
+	if err := h.db.First(&provider, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "provider not found"})
+		return
+	}
+	c.JSON(http.StatusOK, toProviderQuery(provider))
+}
+
+type createProviderRequest struct {
+	NPI        string `json:"npi" binding:"required,len=10"`
+	Name       string `json:"name" binding:"required"`
+	Specialty  string `json:"specialty"`
+	Email      string `json:"email"`
+	Phone      string `json:"phone"`
+	FacilityID uint   `json:"facility_id"`
+}
+
+// Create adds a new provider. It does not check the caller's role.
+func (h *ProviderHandler) Create(c *gin.Context) {
+	var req createProviderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	provider := models.Provider{
+		NPI:        req.NPI,
+		Name:       req.Name,
+		Specialty:  req.Specialty,
+		Email:      req.Email,
+		Phone:      req.Phone,
+		FacilityID: req.FacilityID,
+	}
+	if err := h.db.Create(&provider).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create provider"})
+		return
+	}
+	c.JSON(http.StatusCreated, toProviderQuery(provider))
+}
+
+type updateProviderRequest struct {
+	Name       *string `json:"name"`
+	Specialty  *string `json:"specialty"`
+	Email      *string `json:"email"`
+	Phone      *string `json:"phone"`
+	FacilityID *uint   `json:"facility_id"`
+}
+
+// Update edits an existing provider. It does not check the caller's role.
+// NPI is immutable once set; registries treat it as a permanent identifier.
+func (h *ProviderHandler) Update(c *gin.Context) {
+	var provider models.Provider
+	if err := h.db.First(&provider, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "provider not found"})
+		return
+	}
+
+	var req updateProviderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if req.Name != nil {
+		provider.Name = *req.Name
+	}
+	if req.Specialty != nil {
+		provider.Specialty = *req.Specialty
+	}
+	if req.Email != nil {
+		provider.Email = *req.Email
+	}
+	if req.Phone != nil {
+		provider.Phone = *req.Phone
+	}
+	if req.FacilityID != nil {
+		provider.FacilityID = *req.FacilityID
+	}
+
+	if err := h.db.Save(&provider).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update provider"})
+		return
+	}
+	c.JSON(http.StatusOK, toProviderQuery(provider))
+}
+
+// Delete soft-deletes a provider. It does not check the caller's role.
+func (h *ProviderHandler) Delete(c *gin.Context) {
+	if err := h.db.Delete(&models.Provider{}, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete provider"})
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func toProviderQuery(p models.Provider) providerQuery {
+	return providerQuery{
+		ID:         p.ID,
+		NPI:        p.NPI,
+		Name:       p.Name,
+		Specialty:  p.Specialty,
+		Email:      p.Email,
+		Phone:      p.Phone,
+		FacilityID: p.FacilityID,
+	}
+}
+
+func toProviderQueries(providers []models.Provider) []providerQuery {
+	out := make([]providerQuery, 0, len(providers))
+	for _, p := range providers {
+		out = append(out, toProviderQuery(p))
+	}
+	return out
+}
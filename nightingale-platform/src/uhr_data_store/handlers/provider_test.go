@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/db"
+	"github.com/nightingale-health/uhr-data-store/models"
+)
+
+func newTestRouter(t *testing.T) (*gin.Engine, *gorm.DB) {
+	t.Helper()
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open in-memory database: %v", err)
+	}
+	if err := db.AutoMigrate(database); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	h := NewProviderHandler(database)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/providers", h.List)
+	r.GET("/providers/:id", h.Get)
+	r.POST("/providers", h.Create)
+	r.PUT("/providers/:id", h.Update)
+	r.DELETE("/providers/:id", h.Delete)
+	return r, database
+}
+
+func TestProviderHandler_CreateThenGet(t *testing.T) {
+	r, _ := newTestRouter(t)
+
+	body, _ := json.Marshal(createProviderRequest{
+		NPI:       "1234567893",
+		Name:      "Dr. Jane Smith",
+		Specialty: "Cardiology",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/providers", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create status = %d, want 201: %s", w.Code, w.Body.String())
+	}
+
+	var created providerQuery
+	if err := json.Unmarshal(w.Body.Bytes(), &created); err != nil {
+		t.Fatalf("unmarshal create response: %v", err)
+	}
+	if created.ID == 0 {
+		t.Fatal("expected a non-zero provider ID")
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/providers/1", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("get status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+	var fetched providerQuery
+	if err := json.Unmarshal(w.Body.Bytes(), &fetched); err != nil {
+		t.Fatalf("unmarshal get response: %v", err)
+	}
+	if fetched.Name != "Dr. Jane Smith" || fetched.Specialty != "Cardiology" {
+		t.Fatalf("fetched provider = %+v, want Dr. Jane Smith / Cardiology", fetched)
+	}
+}
+
+func TestProviderHandler_ListFiltersBySpecialtyAndSearch(t *testing.T) {
+	r, database := newTestRouter(t)
+
+	database.Create(&models.Provider{NPI: "1111111111", Name: "Dr. Alice Chen", Specialty: "Pediatrics"})
+	database.Create(&models.Provider{NPI: "2222222222", Name: "Dr. Bob Alvarez", Specialty: "Cardiology"})
+	database.Create(&models.Provider{NPI: "3333333333", Name: "Dr. Carol Diaz", Specialty: "Cardiology"})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/providers?specialty=Cardiology", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		Providers []providerQuery `json:"providers"`
+		Total     int64           `json:"total"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if resp.Total != 2 || len(resp.Providers) != 2 {
+		t.Fatalf("specialty filter returned %d providers, want 2", resp.Total)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/providers?q=alice", nil))
+	resp.Providers = nil
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if resp.Total != 1 || resp.Providers[0].Name != "Dr. Alice Chen" {
+		t.Fatalf("name search returned %+v, want exactly Dr. Alice Chen", resp.Providers)
+	}
+}
+
+func TestProviderHandler_DeleteIsSoftAndExcludesFromList(t *testing.T) {
+	r, database := newTestRouter(t)
+	database.Create(&models.Provider{NPI: "4444444444", Name: "Dr. Dana Lee", Specialty: "Neurology"})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/providers/1", nil))
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("delete status = %d, want 204", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/providers/1", nil))
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("get after delete status = %d, want 404", w.Code)
+	}
+
+	var withDeleted models.Provider
+	if err := database.Unscoped().First(&withDeleted, 1).Error; err != nil {
+		t.Fatalf("expected the soft-deleted row to still exist: %v", err)
+	}
+}
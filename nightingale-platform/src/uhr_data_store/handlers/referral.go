@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/models"
+	"github.com/nightingale-health/uhr-data-store/queryparams"
+)
+
+// ReferralHandler serves the backend's referral routes.
+type ReferralHandler struct {
+	db *gorm.DB
+}
+
+// NewReferralHandler constructs a ReferralHandler backed by db.
+func NewReferralHandler(db *gorm.DB) *ReferralHandler {
+	return &ReferralHandler{db: db}
+}
+
+type referralQuery struct {
+	ID                  uint   `json:"id"`
+	PatientID           uint   `json:"patient_id"`
+	ReferringProviderID uint   `json:"referring_provider_id"`
+	ReceivingProviderID uint   `json:"receiving_provider_id"`
+	Reason              string `json:"reason"`
+	Status              string `json:"status"`
+	Notes               string `json:"notes,omitempty"`
+}
+
+// List returns referrals, filterable by patient or by the provider on
+// either side, paginated.
+func (h *ReferralHandler) List(c *gin.Context) {
+	page, perPage := queryparams.Pagination(c)
+
+	query := h.db.Model(&models.Referral{})
+	if patientID := c.Query("patient_id"); patientID != "" {
+		query = query.Where("patient_id = ?", patientID)
+	}
+	if receivingProviderID := c.Query("receiving_provider_id"); receivingProviderID != "" {
+		query = query.Where("receiving_provider_id = ?", receivingProviderID)
+	}
+	if referringProviderID := c.Query("referring_provider_id"); referringProviderID != "" {
+		query = query.Where("referring_provider_id = ?", referringProviderID)
+	}
+	if status := c.Query("status"); status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count referrals"})
+		return
+	}
+
+	var referrals []models.Referral
+	if err := query.Order("created_at DESC").Offset((page - 1) * perPage).Limit(perPage).Find(&referrals).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list referrals"})
+		return
+	}
+
+	out := make([]referralQuery, 0, len(referrals))
+	for _, r := range referrals {
+		out = append(out, toReferralQuery(r))
+	}
+	c.JSON(http.StatusOK, gin.H{"referrals": out, "total": total, "page": page, "per_page": perPage})
+}
+
+// Get returns a single referral by ID.
+func (h *ReferralHandler) Get(c *gin.Context) {
+	var referral models.Referral
+	if err := h.db.First(&referral, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "referral not found"})
+		return
+	}
+	c.JSON(http.StatusOK, toReferralQuery(referral))
+}
+
+type createReferralRequest struct {
+	PatientID           uint   `json:"patient_id" binding:"required"`
+	ReferringProviderID uint   `json:"referring_provider_id" binding:"required"`
+	ReceivingProviderID uint   `json:"receiving_provider_id" binding:"required"`
+	Reason              string `json:"reason" binding:"required"`
+	Notes               string `json:"notes"`
+}
+
+// Create places a new referral.
+func (h *ReferralHandler) Create(c *gin.Context) {
+	var req createReferralRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	referral := models.Referral{
+		PatientID:           req.PatientID,
+		ReferringProviderID: req.ReferringProviderID,
+		ReceivingProviderID: req.ReceivingProviderID,
+		Reason:              req.Reason,
+		Status:              models.ReferralPending,
+		Notes:               req.Notes,
+	}
+	if err := h.db.Create(&referral).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create referral"})
+		return
+	}
+	c.JSON(http.StatusCreated, toReferralQuery(referral))
+}
+
+type updateReferralStatusRequest struct {
+	Status string `json:"status" binding:"required"`
+}
+
+// UpdateStatus transitions a referral, e.g. the receiving provider
+// accepting or declining it, or marking it complete once the consult
+// happens.
+func (h *ReferralHandler) UpdateStatus(c *gin.Context) {
+	var referral models.Referral
+	if err := h.db.First(&referral, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "referral not found"})
+		return
+	}
+
+	var req updateReferralStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	referral.Status = models.ReferralStatus(req.Status)
+	if err := h.db.Save(&referral).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update referral"})
+		return
+	}
+	c.JSON(http.StatusOK, toReferralQuery(referral))
+}
+
+func toReferralQuery(r models.Referral) referralQuery {
+	return referralQuery{
+		ID:                  r.ID,
+		PatientID:           r.PatientID,
+		ReferringProviderID: r.ReferringProviderID,
+		ReceivingProviderID: r.ReceivingProviderID,
+		Reason:              r.Reason,
+		Status:              string(r.Status),
+		Notes:               r.Notes,
+	}
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/db"
+)
+
+func newTestReferralRouter(t *testing.T) (*gin.Engine, *gorm.DB) {
+	t.Helper()
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open in-memory database: %v", err)
+	}
+	if err := db.AutoMigrate(database); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	h := NewReferralHandler(database)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/referrals", h.List)
+	r.GET("/referrals/:id", h.Get)
+	r.POST("/referrals", h.Create)
+	r.PUT("/referrals/:id/status", h.UpdateStatus)
+	return r, database
+}
+
+func TestReferralHandler_CreateDefaultsToPendingAndAcceptTransitions(t *testing.T) {
+	r, _ := newTestReferralRouter(t)
+
+	body, _ := json.Marshal(createReferralRequest{
+		PatientID: 1, ReferringProviderID: 2, ReceivingProviderID: 3, Reason: "suspected arrhythmia",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/referrals", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create status = %d, want 201: %s", w.Code, w.Body.String())
+	}
+	var created referralQuery
+	json.Unmarshal(w.Body.Bytes(), &created)
+	if created.Status != "pending" {
+		t.Fatalf("status = %q, want pending", created.Status)
+	}
+
+	body, _ = json.Marshal(updateReferralStatusRequest{Status: "accepted"})
+	req = httptest.NewRequest(http.MethodPut, "/referrals/1/status", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("update status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/referrals?receiving_provider_id=3&status=accepted", nil))
+	var resp struct {
+		Referrals []referralQuery `json:"referrals"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if len(resp.Referrals) != 1 || resp.Referrals[0].Status != "accepted" {
+		t.Fatalf("filtered list = %+v, want one accepted referral", resp.Referrals)
+	}
+}
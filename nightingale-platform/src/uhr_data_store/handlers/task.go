@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/models"
+	"github.com/nightingale-health/uhr-data-store/queryparams"
+)
+
+// TaskHandler serves the backend's care-team task routes.
+type TaskHandler struct {
+	db *gorm.DB
+}
+
+// NewTaskHandler constructs a TaskHandler backed by db.
+func NewTaskHandler(db *gorm.DB) *TaskHandler {
+	return &TaskHandler{db: db}
+}
+
+type taskQuery struct {
+	ID          uint       `json:"id"`
+	PatientID   uint       `json:"patient_id"`
+	AssigneeID  uint       `json:"assignee_id"`
+	Description string     `json:"description"`
+	Priority    string     `json:"priority"`
+	Status      string     `json:"status"`
+	DueAt       time.Time  `json:"due_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// List returns tasks, filterable by assignee, patient, and status,
+// paginated. Pass overdue=true to restrict to open tasks whose due date
+// has passed.
+func (h *TaskHandler) List(c *gin.Context) {
+	page, perPage := queryparams.Pagination(c)
+
+	query := h.db.Model(&models.Task{})
+	if assigneeID := c.Query("assignee_id"); assigneeID != "" {
+		query = query.Where("assignee_id = ?", assigneeID)
+	}
+	if patientID := c.Query("patient_id"); patientID != "" {
+		query = query.Where("patient_id = ?", patientID)
+	}
+	if status := c.Query("status"); status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if c.Query("overdue") == "true" {
+		query = query.Where("status != ? AND due_at < ?", models.TaskStatusDone, time.Now())
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count tasks"})
+		return
+	}
+
+	var tasks []models.Task
+	if err := query.Order("due_at ASC").Offset((page - 1) * perPage).Limit(perPage).Find(&tasks).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list tasks"})
+		return
+	}
+
+	out := make([]taskQuery, 0, len(tasks))
+	for _, t := range tasks {
+		out = append(out, toTaskQuery(t))
+	}
+	c.JSON(http.StatusOK, gin.H{"tasks": out, "total": total, "page": page, "per_page": perPage})
+}
+
+type createTaskRequest struct {
+	PatientID   uint      `json:"patient_id" binding:"required"`
+	AssigneeID  uint      `json:"assignee_id" binding:"required"`
+	Description string    `json:"description" binding:"required"`
+	Priority    string    `json:"priority"`
+	DueAt       time.Time `json:"due_at" binding:"required"`
+}
+
+// Create assigns a new task.
+func (h *TaskHandler) Create(c *gin.Context) {
+	var req createTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	priority := models.TaskPriority(req.Priority)
+	if priority == "" {
+		priority = models.TaskPriorityNormal
+	}
+
+	task := models.Task{
+		PatientID:   req.PatientID,
+		AssigneeID:  req.AssigneeID,
+		Description: req.Description,
+		Priority:    priority,
+		Status:      models.TaskStatusOpen,
+		DueAt:       req.DueAt,
+	}
+	if err := h.db.Create(&task).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create task"})
+		return
+	}
+	c.JSON(http.StatusCreated, toTaskQuery(task))
+}
+
+type updateTaskStatusRequest struct {
+	Status string `json:"status" binding:"required"`
+}
+
+// UpdateStatus transitions a task, e.g. to in_progress or done. Marking a
+// task done stamps CompletedAt; overdue queries exclude done tasks by status.
+func (h *TaskHandler) UpdateStatus(c *gin.Context) {
+	var task models.Task
+	if err := h.db.First(&task, c.Param("id")).Error; err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "task not found"})
+		return
+	}
+
+	var req updateTaskStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	task.Status = models.TaskStatus(req.Status)
+	if task.Status == models.TaskStatusDone {
+		now := time.Now()
+		task.CompletedAt = &now
+	}
+	if err := h.db.Save(&task).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to update task"})
+		return
+	}
+	c.JSON(http.StatusOK, toTaskQuery(task))
+}
+
+func toTaskQuery(t models.Task) taskQuery {
+	return taskQuery{
+		ID:          t.ID,
+		PatientID:   t.PatientID,
+		AssigneeID:  t.AssigneeID,
+		Description: t.Description,
+		Priority:    string(t.Priority),
+		Status:      string(t.Status),
+		DueAt:       t.DueAt,
+		CompletedAt: t.CompletedAt,
+	}
+}
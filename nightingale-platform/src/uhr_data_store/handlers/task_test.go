@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/db"
+	"github.com/nightingale-health/uhr-data-store/models"
+)
+
+func newTestTaskRouter(t *testing.T) (*gin.Engine, *gorm.DB) {
+	t.Helper()
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open in-memory database: %v", err)
+	}
+	if err := db.AutoMigrate(database); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	h := NewTaskHandler(database)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/tasks", h.List)
+	r.POST("/tasks", h.Create)
+	r.PUT("/tasks/:id/status", h.UpdateStatus)
+	return r, database
+}
+
+func TestTaskHandler_OverdueQueryExcludesCompletedTasks(t *testing.T) {
+	r, database := newTestTaskRouter(t)
+	database.Create(&models.Task{PatientID: 1, AssigneeID: 2, Description: "call about lab result", Priority: models.TaskPriorityHigh, Status: models.TaskStatusOpen, DueAt: time.Now().Add(-24 * time.Hour)})
+	database.Create(&models.Task{PatientID: 1, AssigneeID: 2, Description: "follow-up call", Priority: models.TaskPriorityNormal, Status: models.TaskStatusOpen, DueAt: time.Now().Add(24 * time.Hour)})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/tasks?overdue=true", nil))
+	var resp struct {
+		Tasks []taskQuery `json:"tasks"`
+		Total int64       `json:"total"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if resp.Total != 1 || resp.Tasks[0].Description != "call about lab result" {
+		t.Fatalf("overdue tasks = %+v, want only the overdue one", resp.Tasks)
+	}
+
+	body, _ := json.Marshal(updateTaskStatusRequest{Status: "done"})
+	req := httptest.NewRequest(http.MethodPut, "/tasks/1/status", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("update status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/tasks?overdue=true", nil))
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if resp.Total != 0 {
+		t.Fatalf("overdue tasks after completion = %d, want 0", resp.Total)
+	}
+}
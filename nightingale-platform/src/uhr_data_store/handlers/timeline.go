@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/models"
+)
+
+// TimelineHandler aggregates a patient's clinical history into one
+// chronological feed, served at GET /patients/:id/timeline.
+type TimelineHandler struct {
+	db *gorm.DB
+}
+
+// NewTimelineHandler constructs a TimelineHandler backed by db.
+func NewTimelineHandler(db *gorm.DB) *TimelineHandler {
+	return &TimelineHandler{db: db}
+}
+
+// timelineCategories are the feed's entry kinds; Get's category query
+// param (comma-separated) filters down to a subset of these.
+const (
+	timelineCategoryEncounter    = "encounter"
+	timelineCategoryNote         = "note"
+	timelineCategoryLabOrder     = "lab_order"
+	timelineCategoryPrescription = "prescription"
+	timelineCategoryDocument     = "document"
+)
+
+var allTimelineCategories = []string{
+	timelineCategoryEncounter,
+	timelineCategoryNote,
+	timelineCategoryLabOrder,
+	timelineCategoryPrescription,
+	timelineCategoryDocument,
+}
+
+type timelineEntry struct {
+	Category   string    `json:"category"`
+	ID         uint      `json:"id"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Summary    string    `json:"summary"`
+}
+
+const timelinePageSize = 25
+
+// Get returns /patients/:id/timeline: a category-filterable, cursor-paged,
+// reverse-chronological feed merged from encounters, notes, lab orders,
+// prescriptions, and documents.
+func (h *TimelineHandler) Get(c *gin.Context) {
+	patientID := c.Param("id")
+
+	categories := allTimelineCategories
+	if raw := c.Query("category"); raw != "" {
+		categories = strings.Split(raw, ",")
+	}
+	wanted := make(map[string]bool, len(categories))
+	for _, cat := range categories {
+		wanted[cat] = true
+	}
+
+	var before time.Time
+	if cursor := c.Query("cursor"); cursor != "" {
+		decoded, err := decodeTimelineCursor(cursor)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid cursor"})
+			return
+		}
+		before = decoded
+	} else {
+		before = time.Now().Add(100 * 365 * 24 * time.Hour)
+	}
+
+	var entries []timelineEntry
+
+	if wanted[timelineCategoryEncounter] {
+		var encounters []models.Encounter
+		h.db.Where("patient_id = ? AND started_at < ?", patientID, before).
+			Order("started_at DESC").Limit(timelinePageSize).Find(&encounters)
+		for _, e := range encounters {
+			entries = append(entries, timelineEntry{
+				Category:   timelineCategoryEncounter,
+				ID:         e.ID,
+				OccurredAt: e.StartedAt,
+				Summary:    fmt.Sprintf("%s encounter", e.Type),
+			})
+		}
+	}
+
+	if wanted[timelineCategoryNote] {
+		var notes []models.ClinicalNote
+		h.db.Where("patient_id = ? AND created_at < ?", patientID, before).
+			Order("created_at DESC").Limit(timelinePageSize).Find(&notes)
+		for _, n := range notes {
+			entries = append(entries, timelineEntry{
+				Category:   timelineCategoryNote,
+				ID:         n.NoteID,
+				OccurredAt: n.CreatedAt,
+				Summary:    fmt.Sprintf("clinical note v%d", n.Version),
+			})
+		}
+	}
+
+	if wanted[timelineCategoryLabOrder] {
+		var labOrders []models.LabOrder
+		h.db.Where("patient_id = ? AND ordered_at < ?", patientID, before).
+			Order("ordered_at DESC").Limit(timelinePageSize).Find(&labOrders)
+		for _, l := range labOrders {
+			entries = append(entries, timelineEntry{
+				Category:   timelineCategoryLabOrder,
+				ID:         l.ID,
+				OccurredAt: l.OrderedAt,
+				Summary:    fmt.Sprintf("lab order: %s", l.TestName),
+			})
+		}
+	}
+
+	if wanted[timelineCategoryPrescription] {
+		var prescriptions []models.Prescription
+		h.db.Where("patient_id = ? AND prescribed_at < ?", patientID, before).
+			Order("prescribed_at DESC").Limit(timelinePageSize).Find(&prescriptions)
+		for _, p := range prescriptions {
+			entries = append(entries, timelineEntry{
+				Category:   timelineCategoryPrescription,
+				ID:         p.ID,
+				OccurredAt: p.PrescribedAt,
+				Summary:    fmt.Sprintf("prescription: %s", p.Medication),
+			})
+		}
+	}
+
+	if wanted[timelineCategoryDocument] {
+		var documents []models.Document
+		h.db.Where("patient_id = ? AND created_at < ?", patientID, before).
+			Order("created_at DESC").Limit(timelinePageSize).Find(&documents)
+		for _, d := range documents {
+			entries = append(entries, timelineEntry{
+				Category:   timelineCategoryDocument,
+				ID:         d.ID,
+				OccurredAt: d.CreatedAt,
+				Summary:    fmt.Sprintf("document: %s", d.FileName),
+			})
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].OccurredAt.After(entries[j].OccurredAt)
+	})
+	if len(entries) > timelinePageSize {
+		entries = entries[:timelinePageSize]
+	}
+
+	resp := gin.H{"entries": entries}
+	if len(entries) == timelinePageSize {
+		resp["next_cursor"] = encodeTimelineCursor(entries[len(entries)-1].OccurredAt)
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func encodeTimelineCursor(t time.Time) string {
+	return base64.URLEncoding.EncodeToString([]byte(strconv.FormatInt(t.UnixNano(), 10)))
+}
+
+func decodeTimelineCursor(cursor string) (time.Time, error) {
+	raw, err := base64.URLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, err
+	}
+	nanos, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, nanos), nil
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/db"
+	"github.com/nightingale-health/uhr-data-store/models"
+)
+
+func newTestTimelineRouter(t *testing.T) (*gin.Engine, *gorm.DB) {
+	t.Helper()
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open in-memory database: %v", err)
+	}
+	if err := db.AutoMigrate(database); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	h := NewTimelineHandler(database)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/patients/:id/timeline", h.Get)
+	return r, database
+}
+
+func TestTimelineHandler_MergesCategoriesInChronologicalOrder(t *testing.T) {
+	r, database := newTestTimelineRouter(t)
+
+	base := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+	database.Create(&models.Encounter{PatientID: 1, ProviderID: 2, Type: models.EncounterOutpatient, StartedAt: base.Add(3 * time.Hour)})
+	database.Create(&models.LabOrder{PatientID: 1, ProviderID: 2, TestCode: "58410-2", TestName: "CBC", Status: models.LabOrderPending, OrderedAt: base.Add(1 * time.Hour)})
+	database.Create(&models.Prescription{PatientID: 1, ProviderID: 2, Medication: "Amoxicillin", Dosage: "500mg", Frequency: "TID", Status: models.PrescriptionActive, PrescribedAt: base.Add(2 * time.Hour)})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/patients/1/timeline", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+
+	var resp struct {
+		Entries []timelineEntry `json:"entries"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if len(resp.Entries) != 3 {
+		t.Fatalf("entries = %d, want 3", len(resp.Entries))
+	}
+	if resp.Entries[0].Category != timelineCategoryEncounter ||
+		resp.Entries[1].Category != timelineCategoryPrescription ||
+		resp.Entries[2].Category != timelineCategoryLabOrder {
+		t.Fatalf("entries not in reverse-chronological order: %+v", resp.Entries)
+	}
+}
+
+func TestTimelineHandler_CategoryFilterRestrictsFeed(t *testing.T) {
+	r, database := newTestTimelineRouter(t)
+
+	base := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+	database.Create(&models.Encounter{PatientID: 1, ProviderID: 2, Type: models.EncounterOutpatient, StartedAt: base})
+	database.Create(&models.LabOrder{PatientID: 1, ProviderID: 2, TestCode: "58410-2", TestName: "CBC", Status: models.LabOrderPending, OrderedAt: base})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/patients/1/timeline?category=lab_order", nil))
+	var resp struct {
+		Entries []timelineEntry `json:"entries"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if len(resp.Entries) != 1 || resp.Entries[0].Category != timelineCategoryLabOrder {
+		t.Fatalf("filtered entries = %+v, want one lab_order entry", resp.Entries)
+	}
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/models"
+	"github.com/nightingale-health/uhr-data-store/queryparams"
+)
+
+// VitalSignHandler serves the backend's vital-sign recording and trend
+// routes.
+type VitalSignHandler struct {
+	db *gorm.DB
+}
+
+// NewVitalSignHandler constructs a VitalSignHandler backed by db.
+func NewVitalSignHandler(db *gorm.DB) *VitalSignHandler {
+	return &VitalSignHandler{db: db}
+}
+
+type vitalSignQuery struct {
+	ID          uint      `json:"id"`
+	PatientID   uint      `json:"patient_id"`
+	EncounterID *uint     `json:"encounter_id,omitempty"`
+	Type        string    `json:"type"`
+	Value       float64   `json:"value"`
+	RecordedAt  time.Time `json:"recorded_at"`
+}
+
+// List returns a patient's vital-sign measurements, optionally filtered by
+// type, paginated and ordered most-recent-first.
+func (h *VitalSignHandler) List(c *gin.Context) {
+	patientID := c.Query("patient_id")
+	if patientID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "patient_id is required"})
+		return
+	}
+	page, perPage := queryparams.Pagination(c)
+
+	query := h.db.Model(&models.VitalSign{}).Where("patient_id = ?", patientID)
+	if vitalType := c.Query("type"); vitalType != "" {
+		query = query.Where("type = ?", vitalType)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to count vital signs"})
+		return
+	}
+
+	var vitals []models.VitalSign
+	if err := query.Order("recorded_at DESC").Offset((page - 1) * perPage).Limit(perPage).Find(&vitals).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list vital signs"})
+		return
+	}
+
+	out := make([]vitalSignQuery, 0, len(vitals))
+	for _, v := range vitals {
+		out = append(out, toVitalSignQuery(v))
+	}
+	c.JSON(http.StatusOK, gin.H{"vital_signs": out, "total": total, "page": page, "per_page": perPage})
+}
+
+// Trend returns a single vital type's measurements for a patient in
+// chronological order, for charting. Unlike List it is not paginated,
+// since trend charts need the full series within the requested window.
+func (h *VitalSignHandler) Trend(c *gin.Context) {
+	patientID := c.Query("patient_id")
+	vitalType := c.Query("type")
+	if patientID == "" || vitalType == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "patient_id and type are required"})
+		return
+	}
+
+	query := h.db.Model(&models.VitalSign{}).Where("patient_id = ? AND type = ?", patientID, vitalType)
+	if since := c.Query("since"); since != "" {
+		sinceTime, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "since must be RFC3339"})
+			return
+		}
+		query = query.Where("recorded_at >= ?", sinceTime)
+	}
+
+	var vitals []models.VitalSign
+	if err := query.Order("recorded_at ASC").Find(&vitals).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to load trend"})
+		return
+	}
+
+	out := make([]vitalSignQuery, 0, len(vitals))
+	for _, v := range vitals {
+		out = append(out, toVitalSignQuery(v))
+	}
+	c.JSON(http.StatusOK, gin.H{"patient_id": patientID, "type": vitalType, "points": out})
+}
+
+type createVitalSignRequest struct {
+	PatientID   uint      `json:"patient_id" binding:"required"`
+	EncounterID *uint     `json:"encounter_id"`
+	Type        string    `json:"type" binding:"required"`
+	Value       float64   `json:"value" binding:"required"`
+	RecordedAt  time.Time `json:"recorded_at" binding:"required"`
+}
+
+// Create records a new vital-sign measurement.
+func (h *VitalSignHandler) Create(c *gin.Context) {
+	var req createVitalSignRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	vital := models.VitalSign{
+		PatientID:   req.PatientID,
+		EncounterID: req.EncounterID,
+		Type:        models.VitalType(req.Type),
+		Value:       req.Value,
+		RecordedAt:  req.RecordedAt,
+	}
+	if err := h.db.Create(&vital).Error; err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to record vital sign"})
+		return
+	}
+	c.JSON(http.StatusCreated, toVitalSignQuery(vital))
+}
+
+func toVitalSignQuery(v models.VitalSign) vitalSignQuery {
+	return vitalSignQuery{
+		ID:          v.ID,
+		PatientID:   v.PatientID,
+		EncounterID: v.EncounterID,
+		Type:        string(v.Type),
+		Value:       v.Value,
+		RecordedAt:  v.RecordedAt,
+	}
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/nightingale-health/uhr-data-store/db"
+)
+
+func newTestVitalSignRouter(t *testing.T) (*gin.Engine, *gorm.DB) {
+	t.Helper()
+	database, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open in-memory database: %v", err)
+	}
+	if err := db.AutoMigrate(database); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	h := NewVitalSignHandler(database)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.GET("/vital-signs", h.List)
+	r.GET("/vital-signs/trend", h.Trend)
+	r.POST("/vital-signs", h.Create)
+	return r, database
+}
+
+func postVital(t *testing.T, r *gin.Engine, patientID uint, value float64, recordedAt time.Time) {
+	t.Helper()
+	body, _ := json.Marshal(createVitalSignRequest{
+		PatientID:  patientID,
+		Type:       "heart_rate",
+		Value:      value,
+		RecordedAt: recordedAt,
+	})
+	req := httptest.NewRequest(http.MethodPost, "/vital-signs", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create vital sign status = %d, want 201: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestVitalSignHandler_TrendReturnsChronologicalSeries(t *testing.T) {
+	r, _ := newTestVitalSignRouter(t)
+
+	base := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+	postVital(t, r, 1, 70, base.Add(2*time.Hour))
+	postVital(t, r, 1, 68, base)
+	postVital(t, r, 1, 72, base.Add(4*time.Hour))
+
+	var resp struct {
+		Points []vitalSignQuery `json:"points"`
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/vital-signs/trend?patient_id=1&type=heart_rate", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("trend status = %d, want 200: %s", w.Code, w.Body.String())
+	}
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	if len(resp.Points) != 3 {
+		t.Fatalf("points = %d, want 3", len(resp.Points))
+	}
+	if resp.Points[0].Value != 68 || resp.Points[1].Value != 70 || resp.Points[2].Value != 72 {
+		t.Fatalf("points not in chronological order: %+v", resp.Points)
+	}
+}
+
+func TestVitalSignHandler_ListRequiresPatientID(t *testing.T) {
+	r, _ := newTestVitalSignRouter(t)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/vital-signs", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400", w.Code)
+	}
+}
@@ -0,0 +1,126 @@
+// Command uhr-data-store runs the UHR backend: the CRUD service behind the
+// API gateway's proxy routes.
+package main
+
+import (
+	"os"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/nightingale-health/uhr-data-store/db"
+	"github.com/nightingale-health/uhr-data-store/handlers"
+	"github.com/nightingale-health/uhr-data-store/objectstore"
+)
+
+func main() {
+	database, err := db.Open(os.Getenv("UHR_DATABASE_DSN"))
+	if err != nil {
+		panic(err)
+	}
+
+	documentStoreDir := os.Getenv("UHR_DOCUMENT_STORE_DIR")
+	if documentStoreDir == "" {
+		documentStoreDir = "/var/lib/uhr-data-store/documents"
+	}
+	documentStore, err := objectstore.NewLocalStore(documentStoreDir)
+	if err != nil {
+		panic(err)
+	}
+
+	providerHandler := handlers.NewProviderHandler(database)
+	patientHandler := handlers.NewPatientHandler(database)
+	appointmentHandler := handlers.NewAppointmentHandler(database)
+	encounterHandler := handlers.NewEncounterHandler(database)
+	clinicalNoteHandler := handlers.NewClinicalNoteHandler(database)
+	prescriptionHandler := handlers.NewPrescriptionHandler(database)
+	labHandler := handlers.NewLabHandler(database)
+	vitalSignHandler := handlers.NewVitalSignHandler(database)
+	allergyHandler := handlers.NewAllergyHandler(database)
+	referralHandler := handlers.NewReferralHandler(database)
+	documentHandler := handlers.NewDocumentHandler(database, documentStore, handlers.NoopScanner{})
+	timelineHandler := handlers.NewTimelineHandler(database)
+	auditHandler := handlers.NewAuditHandler(database)
+	consentHandler := handlers.NewConsentHandler(database)
+	taskHandler := handlers.NewTaskHandler(database)
+	organizationHandler := handlers.NewOrganizationHandler(database)
+
+	r := gin.New()
+	r.GET("/providers", providerHandler.List)
+	r.GET("/providers/:id", providerHandler.Get)
+	r.POST("/providers", providerHandler.Create)
+	r.PUT("/providers/:id", providerHandler.Update)
+	r.DELETE("/providers/:id", providerHandler.Delete)
+
+	r.GET("/patients", patientHandler.List)
+	r.GET("/patients/:id", patientHandler.Get)
+	r.POST("/patients", patientHandler.Create)
+	r.PUT("/patients/:id", patientHandler.Update)
+	r.DELETE("/patients/:id", patientHandler.Delete)
+	r.POST("/patients/:id/restore", patientHandler.Restore)
+	r.POST("/patients/:id/merge", patientHandler.Merge)
+
+	r.GET("/appointments", appointmentHandler.List)
+	r.GET("/appointments/:id", appointmentHandler.Get)
+	r.POST("/appointments", appointmentHandler.Create)
+	r.PUT("/appointments/:id/status", appointmentHandler.UpdateStatus)
+
+	r.GET("/encounters", encounterHandler.List)
+	r.GET("/encounters/:id", encounterHandler.Get)
+	r.POST("/encounters", encounterHandler.Create)
+	r.POST("/encounters/:id/close", encounterHandler.Close)
+
+	r.GET("/clinical-notes", clinicalNoteHandler.List)
+	r.GET("/clinical-notes/:note_id", clinicalNoteHandler.Get)
+	r.GET("/clinical-notes/:note_id/history", clinicalNoteHandler.History)
+	r.POST("/clinical-notes", clinicalNoteHandler.Create)
+	r.PUT("/clinical-notes/:note_id", clinicalNoteHandler.Update)
+
+	r.GET("/prescriptions", prescriptionHandler.List)
+	r.GET("/prescriptions/:id", prescriptionHandler.Get)
+	r.POST("/prescriptions", prescriptionHandler.Create)
+	r.PUT("/prescriptions/:id/status", prescriptionHandler.UpdateStatus)
+
+	r.GET("/lab-orders", labHandler.List)
+	r.GET("/lab-orders/:id", labHandler.Get)
+	r.POST("/lab-orders", labHandler.Create)
+	r.POST("/lab-orders/:id/results", labHandler.AddResult)
+
+	r.GET("/vital-signs", vitalSignHandler.List)
+	r.GET("/vital-signs/trend", vitalSignHandler.Trend)
+	r.POST("/vital-signs", vitalSignHandler.Create)
+
+	r.GET("/allergies", allergyHandler.List)
+	r.POST("/allergies", allergyHandler.Create)
+	r.POST("/allergies/:id/resolve", allergyHandler.Resolve)
+
+	r.GET("/referrals", referralHandler.List)
+	r.GET("/referrals/:id", referralHandler.Get)
+	r.POST("/referrals", referralHandler.Create)
+	r.PUT("/referrals/:id/status", referralHandler.UpdateStatus)
+
+	r.GET("/documents", documentHandler.List)
+	r.POST("/documents", documentHandler.Upload)
+	r.GET("/documents/:id/download", documentHandler.Download)
+	r.DELETE("/documents/:id", documentHandler.Delete)
+
+	r.GET("/patients/:id/timeline", timelineHandler.Get)
+
+	r.GET("/audit/:table/:id/history", auditHandler.History)
+
+	r.GET("/consents", consentHandler.List)
+	r.POST("/consents", consentHandler.Create)
+	r.POST("/consents/:id/revoke", consentHandler.Revoke)
+
+	r.GET("/tasks", taskHandler.List)
+	r.POST("/tasks", taskHandler.Create)
+	r.PUT("/tasks/:id/status", taskHandler.UpdateStatus)
+
+	r.GET("/organizations", organizationHandler.ListOrganizations)
+	r.POST("/organizations", organizationHandler.CreateOrganization)
+	r.GET("/facilities", organizationHandler.ListFacilities)
+	r.POST("/facilities", organizationHandler.CreateFacility)
+	r.GET("/departments", organizationHandler.ListDepartments)
+	r.POST("/departments", organizationHandler.CreateDepartment)
+
+	r.Run(":8090")
+}
@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// AllergySeverity tracks how serious a reaction is, driving the UI's
+// prominence for allergy-alert banners.
+type AllergySeverity string
+
+const (
+	AllergyMild     AllergySeverity = "mild"
+	AllergyModerate AllergySeverity = "moderate"
+	AllergySevere   AllergySeverity = "severe"
+)
+
+// Allergy is a coded allergy or intolerance on a patient's chart. Coding it
+// (RxNorm for drugs, SNOMED CT otherwise) rather than storing free text lets
+// the AI interaction-checking endpoints match against it reliably.
+type Allergy struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	PatientID      uint            `gorm:"index;not null"`
+	Substance      string          `gorm:"size:255;not null"`
+	CodeSystem     string          `gorm:"size:32;not null"` // e.g. "RxNorm", "SNOMED-CT"
+	Code           string          `gorm:"size:32;not null"`
+	Reaction       string          `gorm:"size:255"`
+	Severity       AllergySeverity `gorm:"size:16;not null"`
+	NoLongerActive bool            `gorm:"not null;default:false"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// AppointmentStatus is the lifecycle state of a booked appointment.
+type AppointmentStatus string
+
+const (
+	AppointmentScheduled AppointmentStatus = "scheduled"
+	AppointmentCancelled AppointmentStatus = "cancelled"
+	AppointmentCompleted AppointmentStatus = "completed"
+	AppointmentNoShow    AppointmentStatus = "no_show"
+)
+
+// Appointment books a patient into a slot on a provider's schedule. Slot
+// validity against the provider's ScheduleTemplate is enforced by
+// AppointmentHandler.Create, not by the database.
+type Appointment struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	PatientID  uint              `gorm:"index;not null"`
+	ProviderID uint              `gorm:"index;not null"`
+	StartTime  time.Time         `gorm:"index;not null"`
+	EndTime    time.Time         `gorm:"not null"`
+	Status     AppointmentStatus `gorm:"size:16;not null;default:scheduled"`
+	Reason     string            `gorm:"size:255"`
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"encoding/json"
+
+	"gorm.io/gorm"
+)
+
+// auditBeforeKey is the gorm.DB instance-scoped key a BeforeUpdate hook
+// stashes the pre-write snapshot under, for the matching AfterUpdate hook
+// on the same statement to read back when it writes the AuditLog row.
+const auditBeforeKey = "audit_before"
+
+// writeAuditLog records one row's write to the audit trail, in the same
+// transaction as the write itself so the two can never drift.
+func writeAuditLog(tx *gorm.DB, table string, id uint, action AuditAction, before, after interface{}) error {
+	beforeJSON, err := json.Marshal(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := json.Marshal(after)
+	if err != nil {
+		return err
+	}
+	return tx.Create(&AuditLog{
+		TableName: table,
+		RecordID:  id,
+		Action:    action,
+		Before:    string(beforeJSON),
+		After:     string(afterJSON),
+	}).Error
+}
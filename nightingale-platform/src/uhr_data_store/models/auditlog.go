@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// AuditAction is the kind of write an AuditLog row records.
+type AuditAction string
+
+const (
+	AuditActionCreate AuditAction = "create"
+	AuditActionUpdate AuditAction = "update"
+	AuditActionDelete AuditAction = "delete"
+)
+
+// AuditLog is a before/after snapshot of a single write to an audited
+// table, required for HIPAA accountability. Before/After are JSON-encoded
+// rather than typed per-table so one table can hold the history for every
+// audited model.
+type AuditLog struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+
+	TableName string      `gorm:"size:64;not null;index"`
+	RecordID  uint        `gorm:"not null;index"`
+	Action    AuditAction `gorm:"size:16;not null"`
+	ActorID   *uint       `gorm:"index"`
+	Before    string      `gorm:"type:text"`
+	After     string      `gorm:"type:text"`
+}
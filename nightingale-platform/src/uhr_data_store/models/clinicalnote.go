@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ClinicalNote is one version of a clinical note. Editing a note never
+// mutates a row in place; ClinicalNoteHandler.Update inserts a new row with
+// the same NoteID and an incremented Version, so the full edit history is
+// always available and nothing is ever silently overwritten.
+type ClinicalNote struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+
+	// NoteID groups every version of the same logical note together; it is
+	// the ID callers refer to a note by, independent of which version is
+	// current.
+	NoteID      uint   `gorm:"index;not null"`
+	Version     int    `gorm:"not null"`
+	PatientID   uint   `gorm:"index;not null"`
+	EncounterID *uint  `gorm:"index"`
+	AuthorID    uint   `gorm:"index;not null"` // provider ID
+	Body        string `gorm:"type:text;not null"`
+}
+
+// AfterCreate writes an audit row for every version: since notes are
+// never mutated in place (see the type doc comment), each new version is
+// an insert. Version 1 is logged as a create and each later version as an
+// update, with the previous version as its before snapshot.
+func (n *ClinicalNote) AfterCreate(tx *gorm.DB) error {
+	var action AuditAction = AuditActionCreate
+	var before interface{}
+	if n.Version > 1 {
+		action = AuditActionUpdate
+		var previous ClinicalNote
+		if err := tx.Session(&gorm.Session{NewDB: true}).
+			Where("note_id = ? AND version = ?", n.NoteID, n.Version-1).First(&previous).Error; err == nil {
+			before = previous
+		}
+	}
+	// A brand-new note has no NoteID yet (the handler sets it to the row's
+	// own ID right after the insert), so fall back to ID.
+	recordID := n.NoteID
+	if recordID == 0 {
+		recordID = n.ID
+	}
+	return writeAuditLog(tx, "clinical_notes", recordID, action, before, n)
+}
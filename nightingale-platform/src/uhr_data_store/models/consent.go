@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+// ConsentScope enumerates what a consent record authorizes access to;
+// the gateway's consent-enforcement layer checks this before releasing
+// data to the named grantee.
+type ConsentScope string
+
+const (
+	ConsentScopeFullRecord    ConsentScope = "full_record"
+	ConsentScopeClinicalNotes ConsentScope = "clinical_notes"
+	ConsentScopeBilling       ConsentScope = "billing"
+	ConsentScopeResearch      ConsentScope = "research"
+)
+
+// Consent is a patient's data-sharing authorization: who (Grantee) may
+// see what (Scope), for how long, and the signature that proves the
+// patient agreed. Revoking one doesn't delete it, since the record of
+// having once consented (and then withdrawn) is itself part of the
+// compliance trail.
+type Consent struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	PatientID       uint         `gorm:"index;not null"`
+	Scope           ConsentScope `gorm:"size:32;not null"`
+	Grantee         string       `gorm:"size:255;not null"` // e.g. a provider ID, org name, or third-party name
+	EffectiveAt     time.Time    `gorm:"not null"`
+	ExpiresAt       *time.Time
+	SignatureName   string `gorm:"size:255;not null"`
+	SignatureMethod string `gorm:"size:64;not null"` // e.g. "electronic", "wet_signature_scan"
+	RevokedAt       *time.Time
+}
+
+// IsActive reports whether the consent currently authorizes access: it
+// must have taken effect, not be expired, and not be revoked.
+func (c *Consent) IsActive(at time.Time) bool {
+	if c.RevokedAt != nil {
+		return false
+	}
+	if at.Before(c.EffectiveAt) {
+		return false
+	}
+	if c.ExpiresAt != nil && at.After(*c.ExpiresAt) {
+		return false
+	}
+	return true
+}
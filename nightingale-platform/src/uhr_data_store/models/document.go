@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// DocumentCategory classifies an uploaded file for display and retention
+// policy purposes.
+type DocumentCategory string
+
+const (
+	DocumentInsuranceCard DocumentCategory = "insurance_card"
+	DocumentConsentForm   DocumentCategory = "consent_form"
+	DocumentImagingReport DocumentCategory = "imaging_report"
+	DocumentOther         DocumentCategory = "other"
+)
+
+// DocumentScanStatus tracks the virus-scan hook's verdict on an upload.
+type DocumentScanStatus string
+
+const (
+	DocumentScanPending  DocumentScanStatus = "pending"
+	DocumentScanClean    DocumentScanStatus = "clean"
+	DocumentScanInfected DocumentScanStatus = "infected"
+)
+
+// Document is the metadata row for a file uploaded against a patient's
+// chart; the bytes themselves live in the object store under StorageKey,
+// not in this table.
+type Document struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+
+	PatientID    uint               `gorm:"index;not null"`
+	UploadedByID uint               `gorm:"not null"`
+	FileName     string             `gorm:"size:255;not null"`
+	ContentType  string             `gorm:"size:100;not null"`
+	SizeBytes    int64              `gorm:"not null"`
+	Category     DocumentCategory   `gorm:"size:32;not null"`
+	StorageKey   string             `gorm:"size:512;not null"`
+	ScanStatus   DocumentScanStatus `gorm:"size:16;not null;default:pending"`
+}
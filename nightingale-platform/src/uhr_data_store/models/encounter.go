@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// EncounterType distinguishes the setting a visit happened in, which
+// downstream billing and timeline rendering both key off of.
+type EncounterType string
+
+const (
+	EncounterOutpatient EncounterType = "outpatient"
+	EncounterInpatient  EncounterType = "inpatient"
+	EncounterTelehealth EncounterType = "telehealth"
+	EncounterEmergency  EncounterType = "emergency"
+)
+
+// Encounter is a single clinical visit: the record that clinical notes,
+// prescriptions, lab orders, and vitals recorded during the visit attach
+// to. It may originate from a booked Appointment (AppointmentID set) or be
+// created directly, e.g. for a walk-in.
+type Encounter struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	PatientID      uint          `gorm:"index;not null"`
+	ProviderID     uint          `gorm:"index;not null"`
+	AppointmentID  *uint         `gorm:"index"`
+	Type           EncounterType `gorm:"size:16;not null"`
+	StartedAt      time.Time     `gorm:"not null"`
+	EndedAt        *time.Time
+	ChiefComplaint string `gorm:"size:500"`
+}
@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+// LabOrderStatus tracks a lab order from being placed to having results.
+type LabOrderStatus string
+
+const (
+	LabOrderPending   LabOrderStatus = "pending"
+	LabOrderComplete  LabOrderStatus = "complete"
+	LabOrderCancelled LabOrderStatus = "cancelled"
+)
+
+// LabOrder is a request for one or more lab tests on a patient, placed by
+// a provider during an encounter. Results are attached separately via
+// LabResult once the lab reports back.
+type LabOrder struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	PatientID   uint           `gorm:"index;not null"`
+	ProviderID  uint           `gorm:"index;not null"`
+	EncounterID *uint          `gorm:"index"`
+	TestCode    string         `gorm:"size:32;not null;index"` // LOINC code
+	TestName    string         `gorm:"size:255;not null"`
+	Status      LabOrderStatus `gorm:"size:16;not null;default:pending"`
+	OrderedAt   time.Time      `gorm:"not null"`
+
+	Results []LabResult
+}
+
+// LabResult is one reported value against a LabOrder. A panel test (e.g. a
+// CBC) produces multiple LabResult rows under the same LabOrderID.
+type LabResult struct {
+	ID         uint `gorm:"primaryKey"`
+	CreatedAt  time.Time
+	LabOrderID uint `gorm:"index;not null"`
+
+	Component     string `gorm:"size:255;not null"` // e.g. "Hemoglobin"
+	Value         string `gorm:"size:64;not null"`
+	Unit          string `gorm:"size:32"`
+	ReferenceLow  *float64
+	ReferenceHigh *float64
+	Abnormal      bool `gorm:"index"`
+}
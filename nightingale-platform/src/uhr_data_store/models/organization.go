@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// Organization is the top of the org-structure hierarchy: a health
+// system or practice group that owns one or more Facilities.
+type Organization struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Name string `gorm:"size:255;not null;index"`
+}
+
+// Facility is a physical site (a hospital, clinic, or office) belonging
+// to an Organization. Patient and Provider carry a bare FacilityID;
+// Facility is the row that ID resolves to. Appointment has no FacilityID.
+type Facility struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	OrganizationID uint   `gorm:"index;not null"`
+	Name           string `gorm:"size:255;not null;index"`
+	AddressLine1   string `gorm:"size:255"`
+	City           string `gorm:"size:128"`
+	State          string `gorm:"size:64"`
+	PostalCode     string `gorm:"size:16"`
+
+	Departments []Department
+}
+
+// Department is a unit within a Facility (e.g. Cardiology, Radiology)
+// that a Provider can belong to for more granular scoping than facility
+// alone.
+type Department struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	FacilityID uint   `gorm:"index;not null"`
+	Name       string `gorm:"size:255;not null;index"`
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Patient is the core demographic record every other clinical entity in
+// this service (appointments, encounters, notes, prescriptions, labs,
+// vitals, allergies, referrals) hangs off of by PatientID. It is PHI end
+// to end; callers outside the gateway's authenticated/authorized paths
+// must never reach this model directly.
+type Patient struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	MRN         string    `gorm:"size:64;uniqueIndex;not null"` // medical record number
+	FirstName   string    `gorm:"size:255;not null;index"`
+	LastName    string    `gorm:"size:255;not null;index"`
+	DateOfBirth time.Time `gorm:"not null"`
+	Sex         string    `gorm:"size:16"`
+	Email       string    `gorm:"size:255"`
+	Phone       string    `gorm:"size:32"`
+
+	AddressLine1 string `gorm:"size:255"`
+	AddressLine2 string `gorm:"size:255"`
+	City         string `gorm:"size:128"`
+	State        string `gorm:"size:64"`
+	PostalCode   string `gorm:"size:16"`
+	Country      string `gorm:"size:64"`
+
+	FacilityID uint `gorm:"index"`
+
+	// MergedIntoID is set when this record was deduplicated into another
+	// patient (see synth-398's merge workflow); a non-nil value marks this
+	// row as a tombstone that should not be surfaced as a live patient even
+	// if DeletedAt is null.
+	MergedIntoID *uint `gorm:"index"`
+}
+
+// BeforeUpdate stashes the pre-write row so AfterUpdate can log a
+// before/after diff; HIPAA requires this table's change history be
+// reconstructable, per §1.4 of EPS-02.
+func (p *Patient) BeforeUpdate(tx *gorm.DB) error {
+	var before Patient
+	if err := tx.Session(&gorm.Session{NewDB: true}).Unscoped().First(&before, p.ID).Error; err != nil {
+		return err
+	}
+	tx.InstanceSet(auditBeforeKey, before)
+	return nil
+}
+
+// AfterUpdate writes the audit row after the update statement runs, in the
+// same transaction.
+func (p *Patient) AfterUpdate(tx *gorm.DB) error {
+	before, _ := tx.InstanceGet(auditBeforeKey)
+	return writeAuditLog(tx, "patients", p.ID, AuditActionUpdate, before, p)
+}
+
+// AfterCreate writes the audit row for a brand-new patient.
+func (p *Patient) AfterCreate(tx *gorm.DB) error {
+	return writeAuditLog(tx, "patients", p.ID, AuditActionCreate, nil, p)
+}
+
+// AfterDelete writes the audit row for a (soft or hard) delete.
+func (p *Patient) AfterDelete(tx *gorm.DB) error {
+	return writeAuditLog(tx, "patients", p.ID, AuditActionDelete, p, nil)
+}
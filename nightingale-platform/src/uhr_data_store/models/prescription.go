@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// PrescriptionStatus tracks a prescription through its lifecycle.
+type PrescriptionStatus string
+
+const (
+	PrescriptionActive    PrescriptionStatus = "active"
+	PrescriptionCompleted PrescriptionStatus = "completed"
+	PrescriptionCancelled PrescriptionStatus = "cancelled"
+)
+
+// Prescription is a medication order for a patient, written by a provider
+// during an encounter.
+type Prescription struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	PatientID    uint               `gorm:"index;not null"`
+	ProviderID   uint               `gorm:"index;not null"`
+	EncounterID  *uint              `gorm:"index"`
+	Medication   string             `gorm:"size:255;not null;index"`
+	Dosage       string             `gorm:"size:128;not null"`
+	Frequency    string             `gorm:"size:128;not null"`
+	Status       PrescriptionStatus `gorm:"size:16;not null;default:active"`
+	PrescribedAt time.Time          `gorm:"not null"`
+	EndDate      *time.Time
+}
@@ -0,0 +1,46 @@
+// Package models holds the backend's GORM models for UHR-SCHEMA-1.0
+// entities.
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Provider is a clinician or care-team member who can be booked for
+// appointments and receive referrals. Providers are scoped to a facility,
+// same as patients (see the organization/facility models); appointments
+// carry no FacilityID of their own.
+//
+// This service applies no role restriction to reading, creating, or
+// editing providers, and the gateway has no provider routes.
+type Provider struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	// NPI is the provider's National Provider Identifier, a 10-digit
+	// number unique across the US healthcare system.
+	NPI        string `gorm:"size:10;uniqueIndex;not null"`
+	Name       string `gorm:"size:255;not null;index"`
+	Specialty  string `gorm:"size:255;index"`
+	Email      string `gorm:"size:255"`
+	Phone      string `gorm:"size:32"`
+	FacilityID uint   `gorm:"index"`
+
+	ScheduleTemplates []ScheduleTemplate
+}
+
+// ScheduleTemplate describes a provider's recurring weekly availability,
+// consumed by appointment booking to compute open slots.
+type ScheduleTemplate struct {
+	ID         uint `gorm:"primaryKey"`
+	ProviderID uint `gorm:"index;not null"`
+
+	// Weekday is 0 (Sunday) through 6 (Saturday), matching time.Weekday.
+	Weekday   time.Weekday
+	StartTime string `gorm:"size:5"` // "HH:MM", local to the facility's timezone
+	EndTime   string `gorm:"size:5"`
+}
@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// ReferralStatus tracks a referral from being placed to the receiving
+// provider acting on it.
+type ReferralStatus string
+
+const (
+	ReferralPending  ReferralStatus = "pending"
+	ReferralAccepted ReferralStatus = "accepted"
+	ReferralDeclined ReferralStatus = "declined"
+	ReferralComplete ReferralStatus = "complete"
+)
+
+// Referral sends a patient from one provider to another, e.g. a primary
+// care physician referring to a specialist. ReferringProviderID and
+// ReceivingProviderID both point at Provider.
+type Referral struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	PatientID           uint           `gorm:"index;not null"`
+	ReferringProviderID uint           `gorm:"index;not null"`
+	ReceivingProviderID uint           `gorm:"index;not null"`
+	Reason              string         `gorm:"size:500;not null"`
+	Status              ReferralStatus `gorm:"size:16;not null;default:pending"`
+	Notes               string         `gorm:"type:text"`
+}
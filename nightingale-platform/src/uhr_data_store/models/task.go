@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// TaskPriority orders a care team's worklist.
+type TaskPriority string
+
+const (
+	TaskPriorityLow    TaskPriority = "low"
+	TaskPriorityNormal TaskPriority = "normal"
+	TaskPriorityHigh   TaskPriority = "high"
+	TaskPriorityUrgent TaskPriority = "urgent"
+)
+
+// TaskStatus tracks a task from assignment to completion.
+type TaskStatus string
+
+const (
+	TaskStatusOpen       TaskStatus = "open"
+	TaskStatusInProgress TaskStatus = "in_progress"
+	TaskStatusDone       TaskStatus = "done"
+)
+
+// Task is a care-team follow-up against a patient, e.g. "call patient
+// about lab result". AssigneeID is a Provider ID.
+type Task struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	PatientID   uint         `gorm:"index;not null"`
+	AssigneeID  uint         `gorm:"index;not null"`
+	Description string       `gorm:"size:500;not null"`
+	Priority    TaskPriority `gorm:"size:16;not null;default:normal"`
+	Status      TaskStatus   `gorm:"size:16;not null;default:open;index"`
+	DueAt       time.Time    `gorm:"not null;index"`
+	CompletedAt *time.Time
+}
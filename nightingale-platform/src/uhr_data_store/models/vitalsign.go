@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// VitalType enumerates the vital-sign measurements this service records.
+// Each type has a fixed unit so trend queries can compare values without
+// doing unit conversion.
+type VitalType string
+
+const (
+	VitalHeartRate        VitalType = "heart_rate"         // bpm
+	VitalBloodPressureSys VitalType = "blood_pressure_sys" // mmHg
+	VitalBloodPressureDia VitalType = "blood_pressure_dia" // mmHg
+	VitalRespiratoryRate  VitalType = "respiratory_rate"   // breaths/min
+	VitalTemperature      VitalType = "temperature"        // Celsius
+	VitalOxygenSaturation VitalType = "oxygen_saturation"  // percent
+	VitalWeight           VitalType = "weight"             // kg
+	VitalHeight           VitalType = "height"             // cm
+)
+
+// VitalSign is a single timestamped measurement taken for a patient, either
+// during an encounter or standalone (e.g. from a home monitoring device).
+type VitalSign struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+
+	PatientID   uint      `gorm:"index;not null"`
+	EncounterID *uint     `gorm:"index"`
+	Type        VitalType `gorm:"size:32;not null;index"`
+	Value       float64   `gorm:"not null"`
+	RecordedAt  time.Time `gorm:"not null;index"`
+}
@@ -0,0 +1,64 @@
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// LocalStore implements Store on the local filesystem, rooted at dir. It
+// exists for development and tests; production deploys should implement
+// Store against S3/GCS/MinIO instead and wire that in at construction
+// time, same pattern as redisclient's interface over the cache backend.
+type LocalStore struct {
+	dir string
+}
+
+// NewLocalStore creates the root directory if needed and returns a Store
+// backed by it.
+func NewLocalStore(dir string) (*LocalStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create object store root: %w", err)
+	}
+	return &LocalStore{dir: dir}, nil
+}
+
+func (s *LocalStore) path(key string) string {
+	return filepath.Join(s.dir, filepath.Clean("/"+key))
+}
+
+// Put writes r to key, creating any parent directories it needs.
+func (s *LocalStore) Put(_ context.Context, key string, r io.Reader) error {
+	path := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("create object directory: %w", err)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("create object: %w", err)
+	}
+	defer f.Close()
+	if _, err := io.Copy(f, r); err != nil {
+		return fmt.Errorf("write object: %w", err)
+	}
+	return nil
+}
+
+// Get opens key for reading. The caller must close the returned reader.
+func (s *LocalStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	f, err := os.Open(s.path(key))
+	if err != nil {
+		return nil, fmt.Errorf("open object: %w", err)
+	}
+	return f, nil
+}
+
+// Delete removes key. Deleting a key that doesn't exist is not an error.
+func (s *LocalStore) Delete(_ context.Context, key string) error {
+	if err := os.Remove(s.path(key)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("delete object: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+// Package objectstore abstracts the blob backend behind the documents
+// module. LocalStore (local filesystem) is the only implementation here and
+// the one main wires in; Store is the seam through which another backend
+// such as S3/GCS/MinIO could be swapped in without touching handlers.
+package objectstore
+
+import (
+	"context"
+	"io"
+)
+
+// Store puts, gets, and deletes blobs by key. Implementations are
+// responsible for their own durability and encryption-at-rest guarantees.
+type Store interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,68 @@
+// Package queryparams centralizes the page/per_page, sort, and typed
+// equality-filter parsing that list endpoints across this service need, so
+// each handler doesn't hand-roll its own slightly-inconsistent version of
+// the same query-string conventions.
+package queryparams
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultPage    = 1
+	defaultPerPage = 20
+	maxPerPage     = 100
+)
+
+// Pagination reads the page/per_page query params, defaulting to page 1
+// and 20 per page, and caps per_page at 100 to bound query cost.
+func Pagination(c *gin.Context) (page, perPage int) {
+	page, err := strconv.Atoi(c.Query("page"))
+	if err != nil || page < 1 {
+		page = defaultPage
+	}
+	perPage, err = strconv.Atoi(c.Query("per_page"))
+	if err != nil || perPage < 1 {
+		perPage = defaultPerPage
+	}
+	if perPage > maxPerPage {
+		perPage = maxPerPage
+	}
+	return page, perPage
+}
+
+// SortWhitelist maps a caller-facing sort field name to the column it
+// translates to, so a "sort" query param can never reach raw SQL.
+type SortWhitelist map[string]string
+
+// Clause reads param (a whitelisted field name, optionally "-"-prefixed
+// for descending) from c and returns the matching ORDER BY clause, falling
+// back to defaultClause when the field is absent or not in the whitelist.
+func (allowed SortWhitelist) Clause(c *gin.Context, param, defaultClause string) string {
+	field := c.Query(param)
+	if field == "" {
+		return defaultClause
+	}
+
+	direction := "ASC"
+	if strings.HasPrefix(field, "-") {
+		direction = "DESC"
+		field = field[1:]
+	}
+	column, ok := allowed[field]
+	if !ok {
+		return defaultClause
+	}
+	return column + " " + direction
+}
+
+// StringFilter returns the value of a caller-supplied equality filter and
+// whether it was present, so handlers have one convention for "filter by
+// this field if given" instead of repeating the c.Query(...) != "" check.
+func StringFilter(c *gin.Context, param string) (value string, ok bool) {
+	value = c.Query(param)
+	return value, value != ""
+}